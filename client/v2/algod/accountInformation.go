@@ -10,9 +10,36 @@ import (
 type AccountInformation struct {
 	c       *Client
 	account string
+	p       accountInformationParams
+}
+
+// accountInformationParams are the query parameters of AccountInformation, which the
+// generated models do not define
+type accountInformationParams struct {
+	// Format returns the account in msgpack or json
+	Format string `url:"format,omitempty"`
+}
+
+// Format sets the encoding of the response, FormatJSON (the default) or FormatMsgpack
+func (s *AccountInformation) Format(format string) *AccountInformation {
+	s.p.Format = format
+	return s
 }
 
 func (s *AccountInformation) Do(ctx context.Context, headers ...*common.Header) (result models.Account, err error) {
-	err = s.c.get(ctx, &result, fmt.Sprintf("/v2/accounts/%s", s.account), nil, headers)
+	path := fmt.Sprintf("/v2/accounts/%s", s.account)
+	switch s.p.Format {
+	case "", FormatJSON:
+		err = s.c.get(ctx, &result, path, s.p, headers)
+	case FormatMsgpack:
+		err = s.c.getMsgpack(ctx, &result, path, s.p, headers)
+	default:
+		err = fmt.Errorf("unsupported format %q", s.p.Format)
+	}
 	return
 }
+
+// DoRaw performs the request and returns the undecoded response, in the format set
+func (s *AccountInformation) DoRaw(ctx context.Context, headers ...*common.Header) ([]byte, error) {
+	return s.c.getRaw(ctx, fmt.Sprintf("/v2/accounts/%s", s.account), s.p, headers)
+}
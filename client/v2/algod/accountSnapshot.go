@@ -0,0 +1,111 @@
+package algod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+)
+
+// Minimum balance requirements in microAlgos
+const (
+	// minBalance is the balance of an account, and the amount it rises by per asset held
+	minBalance = 100000
+	// appFlatParamsMinBalance is added per application created
+	appFlatParamsMinBalance = 100000
+	// appFlatOptInMinBalance is added per application opted in to
+	appFlatOptInMinBalance = 100000
+	// schemaMinBalancePerEntry is added per key-value pair allowed by the schemas of the
+	// applications created and opted in to, plus schemaUintMinBalance or
+	// schemaBytesMinBalance by value type
+	schemaMinBalancePerEntry = 25000
+	schemaUintMinBalance     = 3500
+	schemaBytesMinBalance    = 25000
+)
+
+// AccountSnapshot is the information of an account indexed by asset and application ID,
+// with application state decoded
+type AccountSnapshot struct {
+	Account models.Account
+	// Assets are the holdings of the account by asset ID
+	Assets map[uint64]models.AssetHolding
+	// CreatedAssets are the parameters of the assets created by the account
+	CreatedAssets map[uint64]models.AssetParams
+	// CreatedApps are the parameters of the applications created by the account
+	CreatedApps map[uint64]models.ApplicationParams
+	// GlobalState is the global state of the applications created by the account
+	GlobalState map[uint64]models.TealState
+	// LocalState is the local state of the account in the applications it opted in to
+	LocalState map[uint64]models.TealState
+}
+
+// MakeAccountSnapshot indexes the information of an account
+func MakeAccountSnapshot(account models.Account) (snapshot AccountSnapshot, err error) {
+	snapshot = AccountSnapshot{
+		Account:       account,
+		Assets:        make(map[uint64]models.AssetHolding, len(account.Assets)),
+		CreatedAssets: make(map[uint64]models.AssetParams, len(account.CreatedAssets)),
+		CreatedApps:   make(map[uint64]models.ApplicationParams, len(account.CreatedApps)),
+		GlobalState:   make(map[uint64]models.TealState, len(account.CreatedApps)),
+		LocalState:    make(map[uint64]models.TealState, len(account.AppsLocalState)),
+	}
+	for _, holding := range account.Assets {
+		snapshot.Assets[holding.AssetId] = holding
+	}
+	for _, asset := range account.CreatedAssets {
+		snapshot.CreatedAssets[asset.Index] = asset.Params
+	}
+	for _, app := range account.CreatedApps {
+		snapshot.CreatedApps[app.Id] = app.Params
+		if snapshot.GlobalState[app.Id], err = models.DecodeTealState(app.Params.GlobalState); err != nil {
+			return AccountSnapshot{}, fmt.Errorf("global state of application %d: %v", app.Id, err)
+		}
+	}
+	for _, local := range account.AppsLocalState {
+		if snapshot.LocalState[local.Id], err = models.DecodeTealState(local.KeyValue); err != nil {
+			return AccountSnapshot{}, fmt.Errorf("local state in application %d: %v", local.Id, err)
+		}
+	}
+	return
+}
+
+// OptedInAsset returns whether the account holds an asset
+func (s AccountSnapshot) OptedInAsset(assetID uint64) bool {
+	_, ok := s.Assets[assetID]
+	return ok
+}
+
+// OptedInApp returns whether the account opted in to an application
+func (s AccountSnapshot) OptedInApp(appID uint64) bool {
+	_, ok := s.LocalState[appID]
+	return ok
+}
+
+// MinimumBalance returns the balance the account must keep for the assets it holds and the
+// applications it created and opted in to
+func (s AccountSnapshot) MinimumBalance() uint64 {
+	schema := s.Account.AppsTotalSchema
+	return minBalance*uint64(1+len(s.Account.Assets)) +
+		appFlatParamsMinBalance*uint64(len(s.Account.CreatedApps)) +
+		appFlatOptInMinBalance*uint64(len(s.Account.AppsLocalState)) +
+		(schemaMinBalancePerEntry+schemaUintMinBalance)*schema.NumUint +
+		(schemaMinBalancePerEntry+schemaBytesMinBalance)*schema.NumByteSlice
+}
+
+// SpendableBalance returns the balance of the account above its minimum balance
+func (s AccountSnapshot) SpendableBalance() uint64 {
+	if minimum := s.MinimumBalance(); s.Account.Amount > minimum {
+		return s.Account.Amount - minimum
+	}
+	return 0
+}
+
+// DoSnapshot performs the request and indexes the account information
+func (s *AccountInformation) DoSnapshot(ctx context.Context, headers ...*common.Header) (AccountSnapshot, error) {
+	account, err := s.Do(ctx, headers...)
+	if err != nil {
+		return AccountSnapshot{}, err
+	}
+	return MakeAccountSnapshot(account)
+}
@@ -0,0 +1,76 @@
+package algod
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountSnapshot(t *testing.T) {
+	const address = "47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU"
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	account := models.Account{
+		Address: address,
+		Amount:  1000000,
+		Assets:  []models.AssetHolding{{AssetId: 5, Amount: 10}, {AssetId: 6, IsFrozen: true}},
+		CreatedAssets: []models.Asset{{Index: 5, Params: models.AssetParams{
+			Total: 10,
+		}}},
+		CreatedApps: []models.Application{{Id: 7, Params: models.ApplicationParams{
+			GlobalState: []models.TealKeyValue{
+				{Key: encode("owner"), Value: models.TealValue{Type: models.TealBytesType, Bytes: encode("alice")}},
+				{Key: encode("count"), Value: models.TealValue{Type: models.TealUintType, Uint: 3}},
+			},
+		}}},
+		AppsLocalState: []models.ApplicationLocalState{{Id: 8, KeyValue: []models.TealKeyValue{
+			{Key: encode("score"), Value: models.TealValue{Type: models.TealUintType, Uint: 42}},
+		}}},
+		AppsTotalSchema: models.ApplicationStateSchema{NumUint: 2, NumByteSlice: 1},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/accounts/"+address, r.URL.Path)
+		w.Write(json.Encode(account))
+	}))
+	defer server.Close()
+	client, err := MakeClient(server.URL, "")
+	require.NoError(t, err)
+
+	snapshot, err := client.AccountInformation(address).DoSnapshot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), snapshot.Assets[5].Amount)
+	require.True(t, snapshot.Assets[6].IsFrozen)
+	require.True(t, snapshot.OptedInAsset(6))
+	require.False(t, snapshot.OptedInAsset(7))
+	require.Equal(t, uint64(10), snapshot.CreatedAssets[5].Total)
+	require.Contains(t, snapshot.CreatedApps, uint64(7))
+
+	owner, ok := snapshot.GlobalState[7].Bytes("owner")
+	require.True(t, ok)
+	require.Equal(t, []byte("alice"), owner)
+	_, ok = snapshot.GlobalState[7].Uint("owner")
+	require.False(t, ok)
+	count, ok := snapshot.GlobalState[7].Uint("count")
+	require.True(t, ok)
+	require.Equal(t, uint64(3), count)
+	require.True(t, snapshot.OptedInApp(8))
+	require.False(t, snapshot.OptedInApp(7))
+	score, _ := snapshot.LocalState[8].Uint("score")
+	require.Equal(t, uint64(42), score)
+
+	// 3 * 100000 for the account and assets, 2 * 100000 for the applications, and the schema
+	require.Equal(t, uint64(500000+2*28500+50000), snapshot.MinimumBalance())
+	require.Equal(t, uint64(1000000-607000), snapshot.SpendableBalance())
+	snapshot.Account.Amount = 100000
+	require.Zero(t, snapshot.SpendableBalance())
+
+	account.AppsLocalState[0].KeyValue[0].Key = "not base64!"
+	_, err = MakeAccountSnapshot(account)
+	require.Error(t, err)
+}
@@ -2,12 +2,20 @@ package algod
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/encoding/json"
 )
 
 const algodAuthHeader = "X-Algo-API-Token"
 
+// The response formats of the endpoints with a Format setter
+const (
+	FormatJSON    = "json"
+	FormatMsgpack = "msgpack"
+)
+
 type Client common.Client
 
 // get performs a GET request to the specific path against the server, assumes JSON response
@@ -20,6 +28,33 @@ func (c *Client) getMsgpack(ctx context.Context, response interface{}, path stri
 	return (*common.Client)(c).GetRawMsgpack(ctx, response, path, request, headers)
 }
 
+// getRaw performs a GET request to the specific path against the server, returns the undecoded response
+func (c *Client) getRaw(ctx context.Context, path string, request interface{}, headers []*common.Header) ([]byte, error) {
+	return (*common.Client)(c).GetRaw(ctx, path, request, headers)
+}
+
+// getCodecJSON performs a GET request to the specific path against the server, assumes a JSON
+// response of types with codec tags, such as blocks and signed transactions
+func (c *Client) getCodecJSON(ctx context.Context, response interface{}, path string, request interface{}, headers []*common.Header) error {
+	body, err := c.getRaw(ctx, path, request, headers)
+	if err != nil {
+		return err
+	}
+	return json.Decode(body, response)
+}
+
+// getFormat performs a GET request to the specific path against the server, for a response
+// in format, FormatMsgpack or FormatJSON. JSON responses are decoded like getCodecJSON.
+func (c *Client) getFormat(ctx context.Context, response interface{}, path string, request interface{}, format string, headers []*common.Header) error {
+	switch format {
+	case FormatMsgpack:
+		return c.getMsgpack(ctx, response, path, request, headers)
+	case FormatJSON:
+		return c.getCodecJSON(ctx, response, path, request, headers)
+	}
+	return fmt.Errorf("unsupported format %q", format)
+}
+
 // post sends a POST request to the given path with the given request object.
 // No query parameters will be sent if request is nil.
 // response must be a pointer to an object as post writes the response there.
@@ -27,6 +62,13 @@ func (c *Client) post(ctx context.Context, response interface{}, path string, re
 	return (*common.Client)(c).Post(ctx, response, path, request, headers)
 }
 
+// delete sends a DELETE request to the given path with the given request object.
+// No query parameters will be sent if request is nil.
+// response must be a pointer to an object as delete writes the response there.
+func (c *Client) delete(ctx context.Context, response interface{}, path string, request interface{}, headers []*common.Header) error {
+	return (*common.Client)(c).Delete(ctx, response, path, request, headers)
+}
+
 // MakeClient is the factory for constructing a ClientV2 for a given endpoint.
 func MakeClient(address string, apiToken string) (c *Client, err error) {
 	commonClient, err := common.MakeClient(address, algodAuthHeader, apiToken)
@@ -34,6 +76,41 @@ func MakeClient(address string, apiToken string) (c *Client, err error) {
 	return
 }
 
+// MakeClientWithOptions is the factory for constructing a ClientV2 for a given endpoint which
+// sends its requests as configured by options.
+func MakeClientWithOptions(address string, apiToken string, options common.ClientOptions) (c *Client, err error) {
+	commonClient, err := common.MakeClientWithOptions(address, algodAuthHeader, apiToken, options)
+	c = (*Client)(commonClient)
+	return
+}
+
+// MakeFailoverClient is the factory for constructing a Client for several algod nodes. Requests
+// go to one which is healthy and not lagging, as reported by their health and status. GETs fail
+// over to the others on errors, and transactions when the node refuses connections; requests
+// administering a node, such as Shutdown and StartCatchup, never do.
+func MakeFailoverClient(endpoints []common.Endpoint, options common.FailoverOptions) (c *Client, err error) {
+	commonClient, err := common.MakeFailoverClient(endpoints, algodAuthHeader, options, func(ctx context.Context, c *common.Client) (uint64, error) {
+		client := (*Client)(c)
+		if err := client.HealthCheck().Do(ctx); err != nil {
+			return 0, err
+		}
+		status, err := client.Status().Do(ctx)
+		return status.LastRound, err
+	})
+	c = (*Client)(commonClient)
+	return
+}
+
+// CheckHealth checks the servers of a failover Client, and returns an error if none is healthy
+func (c *Client) CheckHealth(ctx context.Context) error {
+	return (*common.Client)(c).CheckHealth(ctx)
+}
+
+// Endpoints returns the state of the servers of a failover Client
+func (c *Client) Endpoints() []common.EndpointState {
+	return (*common.Client)(c).Endpoints()
+}
+
 func (c *Client) AccountInformation(account string) *AccountInformation {
 	return &AccountInformation{c: c, account: account}
 }
@@ -58,10 +135,26 @@ func (c *Client) PendingTransactions() *PendingTransactions {
 	return &PendingTransactions{c: c}
 }
 
+func (c *Client) RegisterParticipationKeys(account string) *RegisterParticipationKeys {
+	return &RegisterParticipationKeys{c: c, account: account}
+}
+
 func (c *Client) SendRawTransaction(tx []byte) *SendRawTransaction {
 	return &SendRawTransaction{c: c, stx: tx}
 }
 
+func (c *Client) Shutdown() *Shutdown {
+	return &Shutdown{c: c}
+}
+
+func (c *Client) StartCatchup(catchpoint string) *StartCatchup {
+	return &StartCatchup{c: c, catchpoint: catchpoint}
+}
+
+func (c *Client) AbortCatchup(catchpoint string) *AbortCatchup {
+	return &AbortCatchup{c: c, catchpoint: catchpoint}
+}
+
 func (c *Client) StatusAfterBlock(round uint64) *StatusAfterBlock {
 	return &StatusAfterBlock{c: c, round: round}
 }
@@ -0,0 +1,52 @@
+package algod
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+)
+
+// ApplicationState reads the state of an application from GetApplicationByID and
+// AccountInformation. See models.TealState.Decode for decoding it into a struct.
+type ApplicationState struct {
+	c             *Client
+	applicationId uint64
+}
+
+// Global returns the global state of the application
+func (s *ApplicationState) Global(ctx context.Context, headers ...*common.Header) (models.TealState, error) {
+	application, err := s.c.GetApplicationByID(s.applicationId).Do(ctx, headers...)
+	if err != nil {
+		return nil, err
+	}
+	return models.DecodeGlobalState(s.applicationId, application.Params.GlobalState)
+}
+
+// Local returns the local state of an account opted in to the application
+func (s *ApplicationState) Local(ctx context.Context, address string, headers ...*common.Header) (models.TealState, error) {
+	account, err := s.c.AccountInformation(address).Do(ctx, headers...)
+	if err != nil {
+		return nil, err
+	}
+	return models.DecodeLocalState(s.applicationId, address, account.AppsLocalState)
+}
+
+// DecodeGlobal decodes the global state of the application into the struct pointed to by v
+func (s *ApplicationState) DecodeGlobal(ctx context.Context, v interface{}, headers ...*common.Header) error {
+	state, err := s.Global(ctx, headers...)
+	if err != nil {
+		return err
+	}
+	return state.Decode(v)
+}
+
+// DecodeLocal decodes the local state of an account in the application into the struct
+// pointed to by v
+func (s *ApplicationState) DecodeLocal(ctx context.Context, address string, v interface{}, headers ...*common.Header) error {
+	state, err := s.Local(ctx, address, headers...)
+	if err != nil {
+		return err
+	}
+	return state.Decode(v)
+}
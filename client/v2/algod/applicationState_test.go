@@ -0,0 +1,58 @@
+package algod
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationState(t *testing.T) {
+	const address = "47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU"
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	responses := map[string]interface{}{
+		"/v2/applications/7": models.Application{Id: 7, Params: models.ApplicationParams{
+			GlobalState: []models.TealKeyValue{
+				{Key: encode("name"), Value: models.TealValue{Type: models.TealBytesType, Bytes: encode("counter")}},
+				{Key: encode("count"), Value: models.TealValue{Type: models.TealUintType, Uint: 3}},
+			},
+		}},
+		"/v2/accounts/" + address: models.Account{Address: address, AppsLocalState: []models.ApplicationLocalState{
+			{Id: 7, KeyValue: []models.TealKeyValue{
+				{Key: encode("count"), Value: models.TealValue{Type: models.TealUintType, Uint: 1}},
+			}},
+		}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !assert.True(t, ok, r.URL.Path) {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(json.Encode(response))
+	}))
+	defer server.Close()
+	client, err := MakeClient(server.URL, "")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var global struct {
+		Name  string `teal:"name"`
+		Count uint64 `teal:"count"`
+	}
+	require.NoError(t, client.ApplicationState(7).DecodeGlobal(ctx, &global))
+	require.Equal(t, "counter", global.Name)
+	require.Equal(t, uint64(3), global.Count)
+
+	var local struct {
+		Count uint64 `teal:"count,uint"`
+	}
+	require.NoError(t, client.ApplicationState(7).DecodeLocal(ctx, address, &local))
+	require.Equal(t, uint64(1), local.Count)
+}
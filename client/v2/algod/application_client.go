@@ -32,3 +32,8 @@ func (c *Client) TealDryrun(request models.DryrunRequest) *TealDryrun {
 	return &TealDryrun{c: c, request: request}
 }
 
+// ApplicationState reads the global state of an application, and the local state of
+// accounts in it, decoded into Go values.
+func (c *Client) ApplicationState(applicationId uint64) *ApplicationState {
+	return &ApplicationState{c: c, applicationId: applicationId}
+}
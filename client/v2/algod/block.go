@@ -22,13 +22,39 @@ type generatedBlockResponse struct {
 	Cert *map[string]interface{} `json:"cert,omitempty"`
 }
 
+// Format sets the encoding of the response, FormatMsgpack (the default) or FormatJSON
+func (s *Block) Format(format string) *Block {
+	s.p.Format = format
+	return s
+}
+
 func (s *Block) Do(ctx context.Context, headers ...*common.Header) (result types.Block, err error) {
-	s.p.Format = "msgpack"
+	result, _, err = s.DoWithCert(ctx, headers...)
+	return
+}
+
+// DoWithCert performs the request and also returns the certificate of the block, which
+// algod only includes in msgpack responses
+func (s *Block) DoWithCert(ctx context.Context, headers ...*common.Header) (result types.Block, cert map[string]interface{}, err error) {
+	if s.p.Format == "" {
+		s.p.Format = FormatMsgpack
+	}
 	var response generatedBlockResponse
-	err = s.c.getMsgpack(ctx, &response, fmt.Sprintf("/v2/blocks/%d", s.round), s.p, headers)
+	err = s.c.getFormat(ctx, &response, fmt.Sprintf("/v2/blocks/%d", s.round), s.p, s.p.Format, headers)
 	if err != nil {
 		return
 	}
 	result = response.Block
+	if response.Cert != nil {
+		cert = *response.Cert
+	}
 	return
 }
+
+// DoRaw performs the request and returns the undecoded response, in the format set
+func (s *Block) DoRaw(ctx context.Context, headers ...*common.Header) ([]byte, error) {
+	if s.p.Format == "" {
+		s.p.Format = FormatMsgpack
+	}
+	return s.c.getRaw(ctx, fmt.Sprintf("/v2/blocks/%d", s.round), s.p, headers)
+}
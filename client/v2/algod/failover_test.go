@@ -0,0 +1,187 @@
+package algod
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failoverNode is an algod stand-in at some round, which fails requests while down and
+// answers them after a delay. Its state is shared with the server's handlers, so the test
+// changes it with set.
+type failoverNode struct {
+	name string
+
+	mu       sync.Mutex
+	round    uint64
+	down     bool
+	delay    time.Duration
+	requests []string
+}
+
+func (n *failoverNode) serve(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, n.name+"-token", r.Header.Get(algodAuthHeader))
+		n.mu.Lock()
+		round, down, delay := n.round, n.down, n.delay
+		if !down && r.URL.Path != "/health" && r.URL.Path != "/v2/accounts/missing" {
+			n.requests = append(n.requests, r.URL.Path)
+		}
+		n.mu.Unlock()
+		if down {
+			http.Error(w, `{"message":"node is down"}`, http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/health":
+		case "/v2/accounts/missing":
+			http.Error(w, `{"message":"account not found"}`, http.StatusNotFound)
+		default:
+			time.Sleep(delay)
+			assert.NoError(t, json.NewEncoder(w).Encode(models.NodeStatus{LastRound: round}))
+		}
+	}))
+}
+
+// set changes the state of the node
+func (n *failoverNode) set(change func(n *failoverNode)) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	change(n)
+}
+
+// takeRequests returns the paths the node has served since it was last called
+func (n *failoverNode) takeRequests() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	requests := n.requests
+	n.requests = nil
+	return requests
+}
+
+func TestFailoverClient(t *testing.T) {
+	nodes := []*failoverNode{{name: "a", round: 100}, {name: "b", round: 99}, {name: "c", round: 90}}
+	var endpoints []common.Endpoint
+	for _, node := range nodes {
+		server := node.serve(t)
+		defer server.Close()
+		endpoints = append(endpoints, common.Endpoint{Address: server.URL, Token: node.name + "-token"})
+	}
+	client, err := MakeFailoverClient(endpoints, common.FailoverOptions{MaxLag: 2})
+	require.NoError(t, err)
+	ctx := context.Background()
+	requested := func() (requests [][]string) {
+		for _, node := range nodes {
+			requests = append(requests, node.takeRequests())
+		}
+		return
+	}
+
+	// the first request checks the nodes, and c lags
+	status, err := client.Status().Do(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), status.LastRound)
+	require.Equal(t, [][]string{{"/v2/status", "/v2/status"}, {"/v2/status"}, {"/v2/status"}}, requested())
+	states := client.Endpoints()
+	require.True(t, states[0].Primary)
+	require.Equal(t, uint64(90), states[2].LastRound)
+
+	// a fails, and the waits stay on b once a is back
+	nodes[0].set(func(n *failoverNode) { n.down = true })
+	_, err = client.StatusAfterBlock(100).Do(ctx)
+	require.NoError(t, err)
+	nodes[0].set(func(n *failoverNode) { n.down = false })
+	require.NoError(t, client.CheckHealth(ctx))
+	requested()
+	_, err = client.StatusAfterBlock(101).Do(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{nil, {"/v2/status/wait-for-block-after/101"}}, requested()[:2])
+	require.True(t, client.Endpoints()[1].Primary)
+
+	// errors about the request itself are not failed over
+	_, err = client.AccountInformation("missing").Do(ctx)
+	require.Error(t, err)
+	require.True(t, client.Endpoints()[1].Primary)
+
+	// b lags, and requests move back to a
+	nodes[0].set(func(n *failoverNode) { n.round = 110 })
+	require.NoError(t, client.CheckHealth(ctx))
+	requested()
+	_, err = client.Status().Do(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"/v2/status"}, nil, nil}, requested())
+
+	// when every node is down, the last error is returned
+	for _, node := range nodes {
+		node.set(func(n *failoverNode) { n.down = true })
+	}
+	require.Error(t, client.CheckHealth(ctx))
+	_, err = client.Status().Do(ctx)
+	var httpError common.HTTPError
+	require.True(t, errors.As(err, &httpError))
+	require.Equal(t, http.StatusServiceUnavailable, httpError.StatusCode)
+}
+
+func TestFailoverClientRequests(t *testing.T) {
+	nodes := []*failoverNode{{name: "a", round: 100}, {name: "b", round: 100}}
+	var servers []*httptest.Server
+	var endpoints []common.Endpoint
+	for _, node := range nodes {
+		server := node.serve(t)
+		defer server.Close()
+		servers = append(servers, server)
+		endpoints = append(endpoints, common.Endpoint{Address: server.URL, Token: node.name + "-token"})
+	}
+	client, err := MakeFailoverClient(endpoints, common.FailoverOptions{ClientOptions: common.ClientOptions{Timeout: 50 * time.Millisecond}})
+	require.NoError(t, err)
+	ctx := context.Background()
+	requested := func() (requests [][]string) {
+		for _, node := range nodes {
+			requests = append(requests, node.takeRequests())
+		}
+		return
+	}
+	require.NoError(t, client.CheckHealth(ctx))
+	requested()
+
+	// requests other than GETs are not failed over once a node may have received them,
+	// and those administering a node never are
+	nodes[0].set(func(n *failoverNode) { n.down = true })
+	require.Error(t, client.Shutdown().Do(ctx))
+	_, err = client.StartCatchup("checkpoint").Do(ctx)
+	require.Error(t, err)
+	_, err = client.AbortCatchup("checkpoint").Do(ctx)
+	require.Error(t, err)
+	require.Error(t, client.RegisterParticipationKeys("account").Do(ctx))
+	_, err = client.SendRawTransaction([]byte{1}).Do(ctx)
+	require.Error(t, err)
+	require.Equal(t, [][]string{nil, nil}, requested())
+	require.True(t, client.Endpoints()[0].Primary)
+
+	// each node is given the whole timeout
+	nodes[0].set(func(n *failoverNode) { n.down, n.delay = false, 100*time.Millisecond })
+	nodes[1].set(func(n *failoverNode) { n.delay = 30 * time.Millisecond })
+	status, err := client.Status().Do(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), status.LastRound)
+	require.True(t, client.Endpoints()[1].Primary)
+	requested()
+
+	// a transaction is submitted to the next node when the primary refuses connections
+	servers[1].Close()
+	nodes[0].set(func(n *failoverNode) { n.delay = 0 })
+	_, err = client.SendRawTransaction([]byte{1}).Do(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"/v2/transactions"}, nil}, requested())
+	require.True(t, client.Endpoints()[0].Primary)
+}
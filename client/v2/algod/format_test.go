@@ -0,0 +1,101 @@
+package algod
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/encoding/json"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormats(t *testing.T) {
+	const address = "47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU"
+	sender, err := types.DecodeAddress(address)
+	require.NoError(t, err)
+	var stxn types.SignedTxn
+	stxn.Txn.Type, stxn.Txn.Sender, stxn.Txn.Amount = types.PaymentTx, sender, 1000
+	var block generatedBlockResponse
+	block.Block.Round, block.Block.Payset = 7, types.Payset{{HasGenesisID: true}}
+	cert := map[string]interface{}{"rnd": int64(7)}
+
+	responses := map[string]interface{}{
+		"/v2/accounts/" + address:                           models.Account{Address: address, Amount: 5000},
+		"/v2/transactions/pending/TXID":                     models.PendingTransactionInfoResponse{Transaction: stxn, ConfirmedRound: 3},
+		"/v2/transactions/pending":                          models.PendingTransactionsResponse{TopTransactions: []types.SignedTxn{stxn}, TotalTransactions: 1},
+		"/v2/accounts/" + address + "/transactions/pending": models.PendingTransactionsResponse{TopTransactions: []types.SignedTxn{stxn}, TotalTransactions: 1},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		response, ok := responses[r.URL.Path]
+		if r.URL.Path == "/v2/blocks/7" {
+			response, ok = block, true
+			if format == FormatMsgpack {
+				withCert := block
+				withCert.Cert = &cert
+				response = withCert
+			}
+		}
+		if !assert.True(t, ok, r.URL.Path) {
+			http.NotFound(w, r)
+			return
+		}
+		switch format {
+		case FormatMsgpack:
+			w.Write(msgpack.Encode(response))
+		case FormatJSON, "":
+			w.Write(json.Encode(response))
+		}
+	}))
+	defer server.Close()
+	client, err := MakeClient(server.URL, "")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, format := range []string{FormatJSON, FormatMsgpack} {
+		account, err := client.AccountInformation(address).Format(format).Do(ctx)
+		require.NoError(t, err, format)
+		require.Equal(t, uint64(5000), account.Amount)
+
+		info, pending, err := client.PendingTransactionInformation("TXID").Format(format).Do(ctx)
+		require.NoError(t, err, format)
+		require.Equal(t, uint64(3), info.ConfirmedRound)
+		require.Equal(t, stxn, pending)
+
+		total, top, err := client.PendingTransactions().Format(format).Do(ctx)
+		require.NoError(t, err, format)
+		require.Equal(t, uint64(1), total)
+		require.Equal(t, []types.SignedTxn{stxn}, top)
+
+		total, top, err = client.PendingTransactionsByAddress(address).Format(format).Do(ctx)
+		require.NoError(t, err, format)
+		require.Equal(t, uint64(1), total)
+		require.Equal(t, []types.SignedTxn{stxn}, top)
+
+		result, blockCert, err := client.Block(7).Format(format).DoWithCert(ctx)
+		require.NoError(t, err, format)
+		require.Equal(t, block.Block, result)
+		if format == FormatMsgpack {
+			require.Equal(t, cert, blockCert)
+		} else {
+			require.Nil(t, blockCert)
+		}
+	}
+
+	raw, err := client.Block(7).DoRaw(ctx)
+	require.NoError(t, err)
+	var decoded generatedBlockResponse
+	require.NoError(t, msgpack.Decode(raw, &decoded))
+	require.Equal(t, types.Round(7), decoded.Block.Round)
+	raw, err = client.AccountInformation(address).DoRaw(ctx)
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"amount": 5000`)
+
+	_, err = client.Block(7).Format("xml").Do(ctx)
+	require.EqualError(t, err, `unsupported format "xml"`)
+}
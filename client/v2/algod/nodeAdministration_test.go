@@ -0,0 +1,52 @@
+package algod
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeAdministration(t *testing.T) {
+	const account = "47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU"
+	const catchpoint = "5000#SE5ELBXTPRZUJZ3PFHXAAFN7LYXRHZ53AOQIUFAKNDDCZAGFQMOA"
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token", r.Header.Get(algodAuthHeader))
+		requests = append(requests, fmt.Sprintf("%s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery))
+		switch r.Method {
+		case http.MethodPost:
+			if r.URL.Path == "/v2/catchup/"+catchpoint {
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"catchup-message":"started"}`)
+			}
+		case http.MethodDelete:
+			fmt.Fprint(w, `{"catchup-message":"aborted"}`)
+		}
+	}))
+	defer server.Close()
+	client, err := MakeClient(server.URL, "token")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, client.RegisterParticipationKeys(account).Fee(2000).KeyDilution(100).RoundLastValid(3000000).NoWait(true).Do(ctx))
+	require.NoError(t, client.Shutdown().Timeout(60).Do(ctx))
+	started, err := client.StartCatchup(catchpoint).Do(ctx)
+	require.NoError(t, err)
+	require.Equal(t, models.CatchpointStartResponse{CatchupMessage: "started"}, started)
+	aborted, err := client.AbortCatchup(catchpoint).Do(ctx)
+	require.NoError(t, err)
+	require.Equal(t, models.CatchpointAbortResponse{CatchupMessage: "aborted"}, aborted)
+
+	require.Equal(t, []string{
+		"POST /v2/register-participation-keys/" + account + "?fee=2000&key-dilution=100&no-wait=true&round-last-valid=3000000",
+		"POST /v2/shutdown?timeout=60",
+		"POST /v2/catchup/" + catchpoint + "?",
+		"DELETE /v2/catchup/" + catchpoint + "?",
+	}, requests)
+}
@@ -19,11 +19,25 @@ func (s *PendingTransactionInformation) Max(max uint64) *PendingTransactionInfor
 	return s
 }
 
-// s.p.Format setter intentionally omitted: this SDK only uses msgpack.
+// Format sets the encoding of the response, FormatMsgpack (the default) or FormatJSON
+func (s *PendingTransactionInformation) Format(format string) *PendingTransactionInformation {
+	s.p.Format = format
+	return s
+}
 
 func (s *PendingTransactionInformation) Do(ctx context.Context, headers ...*common.Header) (response models.PendingTransactionInfoResponse, stxn types.SignedTxn, err error) {
-	s.p.Format = "msgpack"
-	err = s.c.getMsgpack(ctx, &response, fmt.Sprintf("/v2/transactions/pending/%s", s.txid), s.p, headers)
+	if s.p.Format == "" {
+		s.p.Format = FormatMsgpack
+	}
+	err = s.c.getFormat(ctx, &response, fmt.Sprintf("/v2/transactions/pending/%s", s.txid), s.p, s.p.Format, headers)
 	stxn = response.Transaction
 	return
 }
+
+// DoRaw performs the request and returns the undecoded response, in the format set
+func (s *PendingTransactionInformation) DoRaw(ctx context.Context, headers ...*common.Header) ([]byte, error) {
+	if s.p.Format == "" {
+		s.p.Format = FormatMsgpack
+	}
+	return s.c.getRaw(ctx, fmt.Sprintf("/v2/transactions/pending/%s", s.txid), s.p, headers)
+}
@@ -17,11 +17,27 @@ func (s *PendingTransactions) Max(max uint64) *PendingTransactions {
 	return s
 }
 
+// Format sets the encoding of the response, FormatMsgpack (the default) or FormatJSON
+func (s *PendingTransactions) Format(format string) *PendingTransactions {
+	s.p.Format = format
+	return s
+}
+
 func (s *PendingTransactions) Do(ctx context.Context, headers ...*common.Header) (total uint64, topTransactions []types.SignedTxn, err error) {
-	s.p.Format = "msgpack"
+	if s.p.Format == "" {
+		s.p.Format = FormatMsgpack
+	}
 	response := models.PendingTransactionsResponse{}
-	err = s.c.getMsgpack(ctx, &response, "/v2/transactions/pending", s.p, headers)
+	err = s.c.getFormat(ctx, &response, "/v2/transactions/pending", s.p, s.p.Format, headers)
 	total = response.TotalTransactions
 	topTransactions = response.TopTransactions
 	return
 }
+
+// DoRaw performs the request and returns the undecoded response, in the format set
+func (s *PendingTransactions) DoRaw(ctx context.Context, headers ...*common.Header) ([]byte, error) {
+	if s.p.Format == "" {
+		s.p.Format = FormatMsgpack
+	}
+	return s.c.getRaw(ctx, "/v2/transactions/pending", s.p, headers)
+}
@@ -19,11 +19,27 @@ func (s *PendingTransactionInformationByAddress) Max(max uint64) *PendingTransac
 	return s
 }
 
+// Format sets the encoding of the response, FormatMsgpack (the default) or FormatJSON
+func (s *PendingTransactionInformationByAddress) Format(format string) *PendingTransactionInformationByAddress {
+	s.p.Format = format
+	return s
+}
+
 func (s *PendingTransactionInformationByAddress) Do(ctx context.Context, headers ...*common.Header) (total uint64, topTransactions []types.SignedTxn, err error) {
-	s.p.Format = "msgpack"
+	if s.p.Format == "" {
+		s.p.Format = FormatMsgpack
+	}
 	response := models.PendingTransactionsResponse{}
-	err = s.c.getMsgpack(ctx, &response, fmt.Sprintf("/v2/accounts/%s/transactions/pending", s.address), s.p, headers)
+	err = s.c.getFormat(ctx, &response, fmt.Sprintf("/v2/accounts/%s/transactions/pending", s.address), s.p, s.p.Format, headers)
 	total = response.TotalTransactions
 	topTransactions = response.TopTransactions
 	return
 }
+
+// DoRaw performs the request and returns the undecoded response, in the format set
+func (s *PendingTransactionInformationByAddress) DoRaw(ctx context.Context, headers ...*common.Header) ([]byte, error) {
+	if s.p.Format == "" {
+		s.p.Format = FormatMsgpack
+	}
+	return s.c.getRaw(ctx, fmt.Sprintf("/v2/accounts/%s/transactions/pending", s.address), s.p, headers)
+}
@@ -0,0 +1,49 @@
+package algod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+)
+
+// RegisterParticipationKeys /v2/register-participation-keys/{address}
+// Generate (or renew) and register participation keys on the node for a given
+// account address.
+type RegisterParticipationKeys struct {
+	c       *Client
+	account string
+	p       models.RegisterParticipationKeysAccountIdParams
+}
+
+// Fee the fee to use when submitting key registration transactions. Defaults to
+// the suggested fee.
+func (s *RegisterParticipationKeys) Fee(fee uint64) *RegisterParticipationKeys {
+	s.p.Fee = fee
+	return s
+}
+
+// KeyDilution value to use for two-level participation key.
+func (s *RegisterParticipationKeys) KeyDilution(keyDilution uint64) *RegisterParticipationKeys {
+	s.p.KeyDilution = keyDilution
+	return s
+}
+
+// RoundLastValid the last round for which the generated participation keys will
+// be valid.
+func (s *RegisterParticipationKeys) RoundLastValid(roundLastValid uint64) *RegisterParticipationKeys {
+	s.p.RoundLastValid = roundLastValid
+	return s
+}
+
+// NoWait don't wait for transaction to commit.
+func (s *RegisterParticipationKeys) NoWait(noWait bool) *RegisterParticipationKeys {
+	s.p.NoWait = noWait
+	return s
+}
+
+// Do performs HTTP request
+func (s *RegisterParticipationKeys) Do(ctx context.Context, headers ...*common.Header) error {
+	return s.c.post(ctx, nil, fmt.Sprintf("/v2/register-participation-keys/%s", s.account), s.p, headers)
+}
@@ -0,0 +1,28 @@
+package algod
+
+import (
+	"context"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+)
+
+// Shutdown /v2/shutdown
+// Special management endpoint to shutdown the node. Optionally provide a timeout
+// parameter to indicate that the node should begin shutting down after a number
+// of seconds.
+type Shutdown struct {
+	c *Client
+	p models.ShutdownParams
+}
+
+// Timeout the number of seconds after which the node begins shutting down.
+func (s *Shutdown) Timeout(timeout uint64) *Shutdown {
+	s.p.Timeout = timeout
+	return s
+}
+
+// Do performs HTTP request
+func (s *Shutdown) Do(ctx context.Context, headers ...*common.Header) error {
+	return s.c.post(ctx, nil, "/v2/shutdown", s.p, headers)
+}
@@ -0,0 +1,22 @@
+package algod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+)
+
+// StartCatchup /v2/catchup/{catchpoint}
+// Given a catchpoint, it starts catching up to this catchpoint.
+type StartCatchup struct {
+	c          *Client
+	catchpoint string
+}
+
+// Do performs HTTP request
+func (s *StartCatchup) Do(ctx context.Context, headers ...*common.Header) (response models.CatchpointStartResponse, err error) {
+	err = s.c.post(ctx, &response, fmt.Sprintf("/v2/catchup/%s", s.catchpoint), nil, headers)
+	return
+}
@@ -0,0 +1,173 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+const (
+	defaultMinBackoff = 100 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// GapError is returned by a Subscription when the node cannot serve a round it has already
+// committed, typically because it is not an archival node and has deleted the block
+type GapError struct {
+	Round     uint64
+	LastRound uint64
+}
+
+func (e GapError) Error() string {
+	return fmt.Sprintf("block %d is not available from the node, whose last round is %d", e.Round, e.LastRound)
+}
+
+// Subscription iterates over the blocks of the chain, in order and without gaps, waiting for
+// each new block to be committed. Failed requests are retried with exponential back-off
+// until the context is done.
+//
+//	sub := client.Subscribe(ctx, checkpoint)
+//	for sub.Next() {
+//		block := sub.Block()
+//		...
+//		checkpoint = sub.Checkpoint()
+//	}
+//	if err := sub.Err(); err != nil {
+//		...
+//	}
+type Subscription struct {
+	// HeaderOnly drops the transactions of the blocks, keeping only their headers. The node
+	// has no header-only endpoint, so each block is still downloaded and decoded in full; it
+	// only saves holding on to the transactions.
+	HeaderOnly bool
+	// MinBackoff is the wait before retrying a failed request, doubled after each failure
+	MinBackoff time.Duration
+	// MaxBackoff bounds the wait between retries
+	MaxBackoff time.Duration
+
+	c          *Client
+	ctx        context.Context
+	next       uint64
+	lastRound  uint64
+	haveStatus bool
+	block      types.Block
+	err        error
+}
+
+// Subscribe returns a Subscription to the blocks from fromRound onwards. To resume a
+// subscription, pass the Checkpoint of the previous one as fromRound.
+func (c *Client) Subscribe(ctx context.Context, fromRound uint64) *Subscription {
+	return &Subscription{
+		MinBackoff: defaultMinBackoff,
+		MaxBackoff: defaultMaxBackoff,
+		c:          c,
+		ctx:        ctx,
+		next:       fromRound,
+	}
+}
+
+// Next waits for the next block and returns true once it is available from Block.
+// Requests which cannot reach the node or get a retryable status, such as 503, are retried.
+// It returns false when the context is done, the subscription has a gap, or the node fails a
+// request which would fail again, such as one with the wrong token, see Err.
+func (s *Subscription) Next() bool {
+	if s.err != nil {
+		return false
+	}
+	backoff := s.MinBackoff
+	for {
+		if err := s.ctx.Err(); err != nil {
+			s.err = err
+			return false
+		}
+		err := s.poll()
+		if err == nil {
+			return true
+		}
+		if _, ok := err.(GapError); ok || !retryable(s.ctx, err) {
+			s.err = err
+			return false
+		}
+		select {
+		case <-s.ctx.Done():
+			s.err = s.ctx.Err()
+			return false
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > s.MaxBackoff {
+			backoff = s.MaxBackoff
+		}
+	}
+}
+
+// poll waits for the next round to be committed and fetches its block
+func (s *Subscription) poll() error {
+	for !s.haveStatus || s.next > s.lastRound {
+		status, err := s.waitForStatus()
+		if err != nil {
+			return err
+		}
+		s.lastRound, s.haveStatus = status, true
+	}
+
+	block, err := s.c.Block(s.next).Do(s.ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return GapError{Round: s.next, LastRound: s.lastRound}
+		}
+		return err
+	}
+	if uint64(block.Round) != s.next {
+		return fmt.Errorf("requested block %d, node returned block %d", s.next, block.Round)
+	}
+	if s.HeaderOnly {
+		block.Payset = nil
+	}
+	s.block = block
+	s.next++
+	return nil
+}
+
+// retryable returns whether a request which failed with err may succeed if sent again: it
+// did not reach the node or timed out, or got a retryable status
+func retryable(ctx context.Context, err error) bool {
+	var httpError common.HTTPError
+	if errors.As(err, &httpError) {
+		return httpError.Retryable()
+	}
+	var netError net.Error
+	return errors.As(err, &netError) || (errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil)
+}
+
+// waitForStatus returns the node's last round, once it is after the last round known
+func (s *Subscription) waitForStatus() (uint64, error) {
+	if !s.haveStatus {
+		status, err := s.c.Status().Do(s.ctx)
+		return status.LastRound, err
+	}
+	status, err := s.c.StatusAfterBlock(s.lastRound).Do(s.ctx)
+	return status.LastRound, err
+}
+
+// Block returns the block Next waited for
+func (s *Subscription) Block() types.Block {
+	return s.block
+}
+
+// Checkpoint returns the round of the next block, from which a new Subscription resumes
+// after the current block
+func (s *Subscription) Checkpoint() uint64 {
+	return s.next
+}
+
+// Err returns the error which ended the subscription: the context's error, a GapError, or
+// the error of a request which would fail again, such as a common.HTTPError
+func (s *Subscription) Err() error {
+	return s.err
+}
@@ -0,0 +1,126 @@
+package algod
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAlgodForSubscription serves a node at round 3 which commits a round on each
+// wait-for-block-after, up to round 5. Blocks before firstRound have been deleted, and
+// the first request for each block in failures fails.
+func mockAlgodForSubscription(t *testing.T, firstRound uint64, failures map[uint64]bool) *httptest.Server {
+	round := uint64(3)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/status", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode(models.NodeStatus{LastRound: round}))
+	})
+	mux.HandleFunc("/v2/status/wait-for-block-after/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v2/status/wait-for-block-after/%d", round), r.URL.Path)
+		if round < 5 {
+			round++
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(models.NodeStatus{LastRound: round}))
+	})
+	mux.HandleFunc("/v2/blocks/", func(w http.ResponseWriter, r *http.Request) {
+		blockRound, err := strconv.ParseUint(path.Base(r.URL.Path), 10, 64)
+		if !assert.NoError(t, err) || !assert.True(t, blockRound <= round) {
+			http.Error(w, `{"message":"unexpected round"}`, http.StatusBadRequest)
+			return
+		}
+		if failures[blockRound] {
+			failures[blockRound] = false
+			http.Error(w, `{"message":"unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		if blockRound < firstRound {
+			http.Error(w, `{"message":"ledger does not have entry"}`, http.StatusNotFound)
+			return
+		}
+		var response generatedBlockResponse
+		response.Block.Round = types.Round(blockRound)
+		response.Block.Payset = types.Payset{{HasGenesisID: true}}
+		w.Write(msgpack.Encode(response))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestSubscribe(t *testing.T) {
+	server := mockAlgodForSubscription(t, 0, map[uint64]bool{2: true})
+	defer server.Close()
+	client, err := MakeClient(server.URL, "")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub := client.Subscribe(ctx, 1)
+	sub.MinBackoff = time.Millisecond
+	var rounds []uint64
+	for sub.Next() {
+		block := sub.Block()
+		require.Len(t, block.Payset, 1)
+		rounds = append(rounds, uint64(block.Round))
+		if block.Round == 4 {
+			break
+		}
+	}
+	require.NoError(t, sub.Err())
+	require.Equal(t, []uint64{1, 2, 3, 4}, rounds)
+	require.Equal(t, uint64(5), sub.Checkpoint())
+
+	resumed := client.Subscribe(ctx, sub.Checkpoint())
+	resumed.HeaderOnly = true
+	require.True(t, resumed.Next())
+	require.Equal(t, types.Round(5), resumed.Block().Round)
+	require.Empty(t, resumed.Block().Payset)
+	cancel()
+	require.False(t, resumed.Next())
+	require.Equal(t, context.Canceled, resumed.Err())
+}
+
+func TestSubscribeGap(t *testing.T) {
+	server := mockAlgodForSubscription(t, 2, nil)
+	defer server.Close()
+	client, err := MakeClient(server.URL, "")
+	require.NoError(t, err)
+
+	sub := client.Subscribe(context.Background(), 1)
+	require.False(t, sub.Next())
+	require.Equal(t, GapError{Round: 1, LastRound: 3}, sub.Err())
+}
+
+func TestSubscribeRejected(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.Error(w, `{"message":"Invalid API Token"}`, http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	client, err := MakeClient(server.URL, "wrong")
+	require.NoError(t, err)
+
+	// a request failing with a status which would not change is not retried
+	sub := client.Subscribe(context.Background(), 1)
+	sub.MinBackoff = time.Millisecond
+	require.False(t, sub.Next())
+	var httpError common.HTTPError
+	require.True(t, errors.As(sub.Err(), &httpError))
+	require.Equal(t, http.StatusUnauthorized, httpError.StatusCode)
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	require.False(t, sub.Next())
+}
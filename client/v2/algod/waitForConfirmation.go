@@ -0,0 +1,143 @@
+package algod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// TransactionNotFoundError is returned by WaitForConfirmation when the node does not know
+// transactions it waited for: they are neither in its pool nor in the blocks committed since
+// the wait started, so they have not arrived, or were dropped from the pool unconfirmed.
+type TransactionNotFoundError struct {
+	TxIDs  []string
+	Rounds uint64
+}
+
+func (e TransactionNotFoundError) Error() string {
+	return fmt.Sprintf("transactions %s not found in the pool or the blocks of the last %d rounds", strings.Join(e.TxIDs, ", "), e.Rounds)
+}
+
+// WaitForConfirmation waits until the transaction txid is confirmed, for at most maxRounds
+// rounds after the node's last round, and returns its pending transaction information.
+// It fails as soon as the node removes the transaction from its pool with an error.
+// A transaction the node does not know has either not arrived yet or been confirmed and left
+// the pool, so it is looked up in the blocks committed since the wait started. The
+// information of a transaction found in a block only holds what the block records: the
+// signed transaction, its confirmed round, closing amount and rewards. Once maxRounds have
+// passed, a transaction still unknown fails with a TransactionNotFoundError.
+func (c *Client) WaitForConfirmation(ctx context.Context, txid string, maxRounds uint64) (models.PendingTransactionInfoResponse, error) {
+	infos, err := c.WaitForGroupConfirmation(ctx, []string{txid}, maxRounds)
+	if err != nil {
+		return models.PendingTransactionInfoResponse{}, err
+	}
+	return infos[0], nil
+}
+
+// WaitForGroupConfirmation is WaitForConfirmation for several transactions, such as the
+// members of a group. It returns their pending transaction information in the order of txids
+// once all of them are confirmed, and fails as soon as any of them is rejected.
+func (c *Client) WaitForGroupConfirmation(ctx context.Context, txids []string, maxRounds uint64) ([]models.PendingTransactionInfoResponse, error) {
+	status, err := c.Status().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastRound := status.LastRound + maxRounds
+	// the blocks before nextBlock have been searched for the transactions the pool does not know
+	nextBlock := status.LastRound
+
+	infos := make([]models.PendingTransactionInfoResponse, len(txids))
+	for round := status.LastRound; ; {
+		unknown := make(map[string]int)
+		for i, txid := range txids {
+			if infos[i].ConfirmedRound > 0 {
+				continue
+			}
+			info, _, err := c.PendingTransactionInformation(txid).Do(ctx)
+			if isNotFound(err) {
+				unknown[txid] = i
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if info.PoolError != "" {
+				return nil, fmt.Errorf("transaction %s was rejected: %s", txid, info.PoolError)
+			}
+			infos[i] = info
+		}
+		for ; len(unknown) > 0 && nextBlock <= round; nextBlock++ {
+			block, err := c.Block(nextBlock).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, stxn := range block.Payset {
+				info := confirmedTransactionInfo(block, stxn)
+				if i, ok := unknown[crypto.TransactionIDString(info.Transaction.Txn)]; ok {
+					infos[i] = info
+					delete(unknown, txids[i])
+				}
+			}
+		}
+
+		var unconfirmed, notFound []string
+		for i, txid := range txids {
+			if infos[i].ConfirmedRound > 0 {
+				continue
+			}
+			if _, ok := unknown[txid]; ok {
+				notFound = append(notFound, txid)
+			} else {
+				unconfirmed = append(unconfirmed, txid)
+			}
+		}
+		if len(unconfirmed)+len(notFound) == 0 {
+			return infos, nil
+		}
+		if round >= lastRound {
+			if len(notFound) > 0 {
+				return nil, TransactionNotFoundError{TxIDs: notFound, Rounds: maxRounds}
+			}
+			return nil, fmt.Errorf("transactions %s not confirmed after %d rounds", strings.Join(unconfirmed, ", "), maxRounds)
+		}
+
+		status, err = c.StatusAfterBlock(round).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		round = status.LastRound
+	}
+}
+
+// confirmedTransactionInfo returns the information recorded in a block of a transaction it
+// committed, with the genesis fields the block omits restored so that its ID can be computed
+func confirmedTransactionInfo(block types.Block, stxn types.SignedTxnInBlock) models.PendingTransactionInfoResponse {
+	signed := stxn.SignedTxn
+	if stxn.HasGenesisID {
+		signed.Txn.GenesisID = block.GenesisID
+	}
+	if stxn.HasGenesisHash {
+		signed.Txn.GenesisHash = block.GenesisHash
+	}
+	return models.PendingTransactionInfoResponse{
+		Transaction:     signed,
+		ConfirmedRound:  uint64(block.Round),
+		ClosingAmount:   uint64(stxn.ClosingAmount),
+		SenderRewards:   uint64(stxn.SenderRewards),
+		ReceiverRewards: uint64(stxn.ReceiverRewards),
+		CloseRewards:    uint64(stxn.CloseRewards),
+	}
+}
+
+// isNotFound returns whether err is the response to a request for something the node does not have
+func isNotFound(err error) bool {
+	var httpError common.HTTPError
+	return errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound
+}
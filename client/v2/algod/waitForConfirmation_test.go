@@ -0,0 +1,136 @@
+package algod
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAlgodForConfirmation serves a node at round 10 which advances a round on each
+// wait-for-block-after, and confirms each transaction in confirmedRounds at its round.
+// Transactions in poolErrors are rejected; any other transaction is unknown to the pool.
+// The transactions in committed have left the pool, and are in the block of their round.
+func mockAlgodForConfirmation(t *testing.T, confirmedRounds map[string]uint64, poolErrors map[string]string, committed map[uint64][]types.SignedTxn) *httptest.Server {
+	round := uint64(10)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/status", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode(models.NodeStatus{LastRound: round}))
+	})
+	mux.HandleFunc("/v2/status/wait-for-block-after/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v2/status/wait-for-block-after/%d", round), r.URL.Path)
+		round++
+		assert.NoError(t, json.NewEncoder(w).Encode(models.NodeStatus{LastRound: round}))
+	})
+	mux.HandleFunc("/v2/transactions/pending/", func(w http.ResponseWriter, r *http.Request) {
+		txid := path.Base(r.URL.Path)
+		var info models.PendingTransactionInfoResponse
+		if poolError, ok := poolErrors[txid]; ok {
+			info.PoolError = poolError
+		} else if confirmedRound, ok := confirmedRounds[txid]; ok {
+			if confirmedRound <= round {
+				info.ConfirmedRound = confirmedRound
+			}
+		} else {
+			http.Error(w, `{"message":"txn does not exist"}`, http.StatusNotFound)
+			return
+		}
+		w.Write(msgpack.Encode(info))
+	})
+	mux.HandleFunc("/v2/blocks/", func(w http.ResponseWriter, r *http.Request) {
+		blockRound, err := strconv.ParseUint(path.Base(r.URL.Path), 10, 64)
+		if !assert.NoError(t, err) || !assert.True(t, blockRound <= round) {
+			http.Error(w, `{"message":"unexpected round"}`, http.StatusBadRequest)
+			return
+		}
+		var response generatedBlockResponse
+		response.Block.Round = types.Round(blockRound)
+		response.Block.GenesisID = "mock-v1"
+		response.Block.GenesisHash = types.Digest{1}
+		for _, stxn := range committed[blockRound] {
+			stxn.Txn.GenesisID = ""
+			stxn.Txn.GenesisHash = types.Digest{}
+			response.Block.Payset = append(response.Block.Payset, types.SignedTxnInBlock{
+				SignedTxnWithAD: types.SignedTxnWithAD{SignedTxn: stxn},
+				HasGenesisID:    true,
+				HasGenesisHash:  true,
+			})
+		}
+		w.Write(msgpack.Encode(response))
+	})
+	return httptest.NewServer(mux)
+}
+
+// committed returns a transaction committed to the mock node's chain, distinguished by its note
+func committed(note byte) types.SignedTxn {
+	return types.SignedTxn{Txn: types.Transaction{
+		Type: types.PaymentTx,
+		Header: types.Header{
+			Sender:      types.Address{note},
+			Fee:         1000,
+			FirstValid:  5,
+			LastValid:   1005,
+			Note:        []byte{note},
+			GenesisID:   "mock-v1",
+			GenesisHash: types.Digest{1},
+		},
+	}}
+}
+
+func TestWaitForConfirmation(t *testing.T) {
+	server := mockAlgodForConfirmation(t, map[string]uint64{"A": 10, "B": 12, "C": 14}, map[string]string{"R": "overspend"}, nil)
+	defer server.Close()
+	client, err := MakeClient(server.URL, "")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	info, err := client.WaitForConfirmation(ctx, "A", 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), info.ConfirmedRound)
+
+	infos, err := client.WaitForGroupConfirmation(ctx, []string{"C", "B"}, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(14), infos[0].ConfirmedRound)
+	require.Equal(t, uint64(12), infos[1].ConfirmedRound)
+
+	_, err = client.WaitForConfirmation(ctx, "R", 5)
+	require.EqualError(t, err, "transaction R was rejected: overspend")
+
+	_, err = client.WaitForGroupConfirmation(ctx, []string{"A", "U"}, 2)
+	require.EqualError(t, err, "transactions U not found in the pool or the blocks of the last 2 rounds")
+	var notFound TransactionNotFoundError
+	require.True(t, errors.As(err, &notFound))
+	require.Equal(t, []string{"U"}, notFound.TxIDs)
+}
+
+func TestWaitForConfirmationOutOfPool(t *testing.T) {
+	// transactions which have left the pool are found in the blocks since the wait started
+	server := mockAlgodForConfirmation(t, map[string]uint64{"A": 10}, nil, map[uint64][]types.SignedTxn{10: {committed(1)}, 12: {committed(2)}})
+	defer server.Close()
+	client, err := MakeClient(server.URL, "")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	first, second := crypto.TransactionIDString(committed(1).Txn), crypto.TransactionIDString(committed(2).Txn)
+	infos, err := client.WaitForGroupConfirmation(ctx, []string{first, "A"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), infos[0].ConfirmedRound)
+	require.Equal(t, committed(1), infos[0].Transaction)
+	require.Equal(t, uint64(10), infos[1].ConfirmedRound)
+	info, err := client.WaitForConfirmation(ctx, second, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), info.ConfirmedRound)
+	require.Equal(t, committed(2), info.Transaction)
+}
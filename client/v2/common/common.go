@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	"github.com/google/go-querystring/query"
@@ -33,6 +34,8 @@ type Client struct {
 	apiHeader string
 	apiToken  string
 	headers   []*Header
+	options   ClientOptions
+	failover  *failover
 }
 
 // MakeClient is the factory for constructing a Client for a given endpoint.
@@ -62,33 +65,23 @@ func MakeClientWithHeaders(address string, apiHeader, apiToken string, headers [
 	return
 }
 
+// BadRequest, InvalidToken, NotFound and InternalError are kept for compatibility; the
+// errors returned are HTTPError and TransactionRejectedError values.
 type BadRequest error
 type InvalidToken error
 type NotFound error
 type InternalError error
 
-// extractError checks if the response signifies an error.
+// extractError checks if the response to a method request for path signifies an error.
 // If so, it returns the error.
 // Otherwise, it returns nil.
-func extractError(resp *http.Response) error {
-	if resp.StatusCode == 200 {
+func extractError(resp *http.Response, method, path string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return nil
 	}
 
 	errorBuf, _ := ioutil.ReadAll(resp.Body) // ignore returned error
-	wrappedError := fmt.Errorf("HTTP %v: %s", resp.Status, errorBuf)
-	switch code := resp.StatusCode; code {
-	case 400:
-		return BadRequest(wrappedError)
-	case 401:
-		return InvalidToken(wrappedError)
-	case 404:
-		return NotFound(wrappedError)
-	case 500:
-		return InternalError(wrappedError)
-	default:
-		return wrappedError
-	}
+	return makeHTTPError(resp.StatusCode, method, path, errorBuf, parseRetryAfter(resp.Header.Get("Retry-After")))
 }
 
 // mergeRawQueries merges two raw queries, appending an "&" if both are non-empty
@@ -104,6 +97,10 @@ func mergeRawQueries(q1, q2 string) string {
 
 // submitForm is a helper used for submitting (ex.) GETs and POSTs to the server
 func (client *Client) submitFormRaw(ctx context.Context, path string, request interface{}, requestMethod string, encodeJSON bool, headers []*Header) (resp *http.Response, err error) {
+	if client.failover != nil {
+		return client.failover.submit(ctx, path, request, requestMethod, encodeJSON, headers)
+	}
+
 	queryURL := client.serverURL
 	queryURL.Path += path
 
@@ -146,9 +143,15 @@ func (client *Client) submitFormRaw(ctx context.Context, path string, request in
 		req.Header.Add(header.Key, header.Value)
 	}
 
-	httpClient := &http.Client{}
 	req = req.WithContext(ctx)
-	resp, err = httpClient.Do(req)
+	if client.options.RequestHook != nil {
+		client.options.RequestHook(req)
+	}
+	start := time.Now()
+	resp, err = client.httpClient().Do(req)
+	if client.options.ResponseHook != nil {
+		client.options.ResponseHook(req, resp, err, time.Since(start))
+	}
 
 	if err != nil {
 		select {
@@ -158,7 +161,7 @@ func (client *Client) submitFormRaw(ctx context.Context, path string, request in
 		}
 		return nil, err
 	}
-	err = extractError(resp)
+	err = extractError(resp, requestMethod, path)
 	if err != nil {
 		resp.Body.Close()
 		return nil, err
@@ -167,14 +170,13 @@ func (client *Client) submitFormRaw(ctx context.Context, path string, request in
 }
 
 func (client *Client) submitForm(ctx context.Context, response interface{}, path string, request interface{}, requestMethod string, encodeJSON bool, headers []*Header) error {
-	resp, err := client.submitFormRaw(ctx, path, request, requestMethod, encodeJSON, headers)
-	if err != nil {
-		return err
-	}
-
-	defer resp.Body.Close()
-	dec := json.NewDecoder(resp.Body)
-	return dec.Decode(&response)
+	return client.send(ctx, path, request, requestMethod, encodeJSON, headers, func(body io.Reader) error {
+		if response == nil {
+			return nil
+		}
+		dec := json.NewDecoder(body)
+		return dec.Decode(&response)
+	})
 }
 
 // Get performs a GET request to the specific path against the server
@@ -183,14 +185,20 @@ func (client *Client) Get(ctx context.Context, response interface{}, path string
 }
 
 func (client *Client) GetRawMsgpack(ctx context.Context, response interface{}, path string, request interface{}, headers []*Header) error {
-	resp, err := client.submitFormRaw(ctx, path, request, "GET", false /* encodeJSON */, headers)
-	if err != nil {
-		return err
-	}
+	return client.send(ctx, path, request, "GET", false /* encodeJSON */, headers, func(body io.Reader) error {
+		dec := msgpack.NewDecoder(body)
+		return dec.Decode(&response)
+	})
+}
 
-	defer resp.Body.Close()
-	dec := msgpack.NewDecoder(resp.Body)
-	return dec.Decode(&response)
+// GetRaw performs a GET request to the specific path against the server, and returns the
+// undecoded body of the response
+func (client *Client) GetRaw(ctx context.Context, path string, request interface{}, headers []*Header) (body []byte, err error) {
+	err = client.send(ctx, path, request, "GET", false /* encodeJSON */, headers, func(r io.Reader) (readErr error) {
+		body, readErr = ioutil.ReadAll(r)
+		return
+	})
+	return
 }
 
 // Post sends a POST request to the given path with the given request object.
@@ -199,3 +207,10 @@ func (client *Client) GetRawMsgpack(ctx context.Context, response interface{}, p
 func (client *Client) Post(ctx context.Context, response interface{}, path string, request interface{}, headers []*Header) error {
 	return client.submitForm(ctx, response, path, request, "POST", true /* encodeJSON */, headers)
 }
+
+// Delete sends a DELETE request to the given path with the given request object.
+// No query parameters will be sent if request is nil.
+// response must be a pointer to an object as delete writes the response there.
+func (client *Client) Delete(ctx context.Context, response interface{}, path string, request interface{}, headers []*Header) error {
+	return client.submitForm(ctx, response, path, request, "DELETE", false /* encodeJSON */, headers)
+}
@@ -0,0 +1,122 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+)
+
+// HTTPError is returned for a response with an error status. The message and data are
+// parsed from the models.ErrorResponse body algod and the indexer return, or the message
+// is the whole body if it is not one.
+type HTTPError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Message    string
+	Data       map[string]interface{}
+	// RetryAfter is the wait the server asked for with a Retry-After header in seconds
+	RetryAfter time.Duration
+}
+
+func (e HTTPError) Error() string {
+	return fmt.Sprintf("HTTP %d %s (%s %s): %s", e.StatusCode, http.StatusText(e.StatusCode), e.Method, e.Path, e.Message)
+}
+
+// Retryable returns whether the same request may succeed later: the server is overloaded,
+// unavailable or failed, as opposed to rejecting the request itself
+func (e HTTPError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// RejectionReason is why a node rejected a transaction
+type RejectionReason string
+
+const (
+	// RejectionOverspend is a sender spending more than its balance
+	RejectionOverspend RejectionReason = "overspend"
+	// RejectionBelowMinBalance is an account left below its minimum balance
+	RejectionBelowMinBalance RejectionReason = "below min balance"
+	// RejectionLogicEval is a logic signature or application program failing or erroring
+	RejectionLogicEval RejectionReason = "logic eval"
+	// RejectionOther is any other reason, see the error's Detail
+	RejectionOther RejectionReason = "other"
+)
+
+// TransactionRejectedError is returned when a node rejects a submitted transaction. It wraps
+// the HTTPError of the response.
+type TransactionRejectedError struct {
+	HTTPError
+	// TxID is the rejected transaction, which may be any member of a group
+	TxID   string
+	Reason RejectionReason
+	// Detail is the node's explanation following the transaction ID
+	Detail string
+}
+
+func (e TransactionRejectedError) Error() string {
+	return fmt.Sprintf("transaction %s rejected (%s): %s", e.TxID, e.Reason, e.Detail)
+}
+
+// Unwrap returns the HTTPError of the response
+func (e TransactionRejectedError) Unwrap() error {
+	return e.HTTPError
+}
+
+// rejectedTransactionPattern finds the transaction ID and the node's explanation in the
+// message of a rejected submission, such as
+// "TransactionPool.Remember: transaction <txid>: overspend (account <address>, ...)"
+var rejectedTransactionPattern = regexp.MustCompile(`transaction ([A-Z2-7]{52}): (.*)`)
+
+// makeHTTPError parses the body of an error response to a request
+func makeHTTPError(statusCode int, method, path string, body []byte, retryAfter time.Duration) error {
+	httpError := HTTPError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       path,
+		Message:    string(body),
+		RetryAfter: retryAfter,
+	}
+	var response models.ErrorResponse
+	if err := json.Unmarshal(body, &response); err == nil && response.Message != "" {
+		httpError.Message = response.Message
+		if response.Data != nil {
+			httpError.Data = *response.Data
+		}
+	}
+
+	if method == http.MethodPost && path == "/v2/transactions" && statusCode == http.StatusBadRequest {
+		if match := rejectedTransactionPattern.FindStringSubmatch(httpError.Message); match != nil {
+			return TransactionRejectedError{
+				HTTPError: httpError,
+				TxID:      match[1],
+				Reason:    rejectionReason(match[2]),
+				Detail:    match[2],
+			}
+		}
+	}
+	return httpError
+}
+
+// rejectionReason classifies the node's explanation of a transaction rejection
+func rejectionReason(detail string) RejectionReason {
+	switch {
+	case strings.Contains(detail, "overspend"):
+		return RejectionOverspend
+	case strings.Contains(detail, "below min"):
+		return RejectionBelowMinBalance
+	case strings.Contains(detail, "logic eval error"), strings.Contains(detail, "rejected by logic"):
+		return RejectionLogicEval
+	}
+	return RejectionOther
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrors(t *testing.T) {
+	const txid = "3MM3QHBVDQUEXA3ZSTCAVIZ5GTZO4MHQFL2PZVL5GRYOC5GUAHRQ"
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/transactions/pending/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"txn does not exist"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/v2/status", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+	})
+	rejections := map[string]string{
+		"overspend": "TransactionPool.Remember: transaction " + txid + ": overspend (account 47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU, data {_struct:{} Status:Offline MicroAlgos:{Raw:1000}}, tried to spend {5000})",
+		"min":       "TransactionPool.Remember: transaction " + txid + ": account 47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU balance 0 below min 100000 (0 assets)",
+		"logic":     "TransactionPool.Remember: transaction " + txid + ": rejected by logic",
+		"other":     "TransactionPool.Remember: transaction " + txid + ": txn dead: round 100 outside of 200--300",
+	}
+	mux.HandleFunc("/v2/transactions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message":"` + rejections["overspend"] + `"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := MakeClient(server.URL, "X-Algo-API-Token", "")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = client.Get(ctx, nil, "/v2/transactions/pending/"+txid, nil, nil)
+	var httpError HTTPError
+	require.True(t, errors.As(err, &httpError))
+	require.Equal(t, HTTPError{StatusCode: 404, Method: "GET", Path: "/v2/transactions/pending/" + txid, Message: "txn does not exist"}, httpError)
+	require.False(t, httpError.Retryable())
+	require.Equal(t, "HTTP 404 Not Found (GET /v2/transactions/pending/"+txid+"): txn does not exist", err.Error())
+
+	err = client.Get(ctx, nil, "/v2/status", nil, nil)
+	require.True(t, errors.As(err, &httpError))
+	require.Equal(t, "upstream unavailable\n", httpError.Message)
+	require.True(t, httpError.Retryable())
+
+	err = client.Post(ctx, nil, "/v2/transactions", []byte{}, nil)
+	var rejected TransactionRejectedError
+	require.True(t, errors.As(err, &rejected))
+	require.Equal(t, txid, rejected.TxID)
+	require.Equal(t, RejectionOverspend, rejected.Reason)
+	require.True(t, errors.As(err, &httpError))
+	require.Equal(t, http.StatusBadRequest, httpError.StatusCode)
+
+	for name, reason := range map[string]RejectionReason{
+		"min":   RejectionBelowMinBalance,
+		"logic": RejectionLogicEval,
+		"other": RejectionOther,
+	} {
+		err = makeHTTPError(http.StatusBadRequest, http.MethodPost, "/v2/transactions", []byte(`{"message":"`+rejections[name]+`"}`), 0)
+		require.True(t, errors.As(err, &rejected), name)
+		require.Equal(t, reason, rejected.Reason)
+		require.Equal(t, "TransactionPool.Remember: transaction "+txid+": "+rejected.Detail, rejections[name])
+	}
+	err = makeHTTPError(http.StatusBadRequest, http.MethodPost, "/v2/transactions", []byte(`{"message":"msgpack decode error","data":{"field":"txn"}}`), 0)
+	require.False(t, errors.As(err, &TransactionRejectedError{}))
+	require.True(t, errors.As(err, &httpError))
+	require.Equal(t, map[string]interface{}{"field": "txn"}, httpError.Data)
+}
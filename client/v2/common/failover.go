@@ -0,0 +1,302 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Endpoint is the address and API token of one of the servers behind a failover Client
+type Endpoint struct {
+	Address string
+	Token   string
+}
+
+// EndpointState is what a failover Client last learnt about one of its servers
+type EndpointState struct {
+	Address   string
+	Healthy   bool
+	LastRound uint64
+	// Primary is true for the server requests are sent to first
+	Primary bool
+}
+
+// HealthCheckFunc checks the server behind c, a Client for a single endpoint, and returns
+// its last round
+type HealthCheckFunc func(ctx context.Context, c *Client) (lastRound uint64, err error)
+
+// FailoverOptions configures a failover Client
+type FailoverOptions struct {
+	ClientOptions
+	// MaxLag is the number of rounds a server may be behind the most advanced healthy
+	// server and still be sent requests
+	MaxLag uint64
+	// HealthCheckInterval is the time after which the servers are checked again before a
+	// request. The servers are checked before the first request; if HealthCheckInterval is
+	// zero, they are only checked again by CheckHealth.
+	HealthCheckInterval time.Duration
+}
+
+// failover routes the requests of a Client to the first of its servers which is healthy and
+// not lagging. It sends every request to the same primary server, so that consecutive
+// requests such as StatusAfterBlock waits see the same chain, until the primary fails,
+// becomes unhealthy or lags.
+type failover struct {
+	check    HealthCheckFunc
+	maxLag   uint64
+	interval time.Duration
+
+	mu        sync.Mutex
+	endpoints []*failoverEndpoint
+	primary   int
+	checkedAt time.Time
+}
+
+type failoverEndpoint struct {
+	client    *Client
+	healthy   bool
+	lastRound uint64
+}
+
+// MakeFailoverClient is the factory for constructing a Client sending its requests to
+// whichever of several endpoints is healthy and not lagging, as reported by check, and
+// failing over to the next one when a GET gets no response or a retryable error, or a
+// transaction cannot be submitted because a server refuses connections. The Timeout of the
+// options applies to each endpoint a request is sent to.
+func MakeFailoverClient(endpoints []Endpoint, apiHeader string, options FailoverOptions, check HealthCheckFunc) (c *Client, err error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints to fail over between")
+	}
+	f := &failover{
+		check:    check,
+		maxLag:   options.MaxLag,
+		interval: options.HealthCheckInterval,
+	}
+	// the Client retries requests itself, each of them on every endpoint
+	endpointOptions := options.ClientOptions
+	endpointOptions.MaxRetries = 0
+	for _, endpoint := range endpoints {
+		client, err := MakeClientWithOptions(endpoint.Address, apiHeader, endpoint.Token, endpointOptions)
+		if err != nil {
+			return nil, err
+		}
+		f.endpoints = append(f.endpoints, &failoverEndpoint{client: client, healthy: true})
+	}
+
+	c = &Client{
+		serverURL: f.endpoints[0].client.serverURL,
+		apiHeader: apiHeader,
+		options:   options.ClientOptions,
+		failover:  f,
+	}
+	return
+}
+
+// CheckHealth checks the servers of a failover Client, and returns an error if none is healthy
+func (client *Client) CheckHealth(ctx context.Context) error {
+	if client.failover == nil {
+		return fmt.Errorf("not a failover client")
+	}
+	return client.failover.checkHealth(ctx)
+}
+
+// Endpoints returns the state of the servers of a failover Client, in the order they were given
+func (client *Client) Endpoints() []EndpointState {
+	if client.failover == nil {
+		return nil
+	}
+	f := client.failover
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	states := make([]EndpointState, len(f.endpoints))
+	for i, e := range f.endpoints {
+		states[i] = EndpointState{
+			Address:   e.client.serverURL.String(),
+			Healthy:   e.healthy,
+			LastRound: e.lastRound,
+			Primary:   i == f.primary,
+		}
+	}
+	return states
+}
+
+// submit sends a request to the primary server, failing over to the others in turn when it
+// may, as decided by failsOver. Each server is given the client's timeout.
+func (f *failover) submit(ctx context.Context, path string, request interface{}, requestMethod string, encodeJSON bool, headers []*Header) (*http.Response, error) {
+	f.mu.Lock()
+	due := f.checkedAt.IsZero() || (f.interval > 0 && time.Since(f.checkedAt) > f.interval)
+	f.mu.Unlock()
+	if due {
+		// a failed check leaves every server unhealthy, which are still tried below
+		f.checkHealth(ctx)
+	}
+
+	var lastErr error
+	for _, i := range f.candidates() {
+		resp, err := f.submitTo(ctx, i, path, request, requestMethod, encodeJSON, headers)
+		if err == nil {
+			f.setPrimary(i)
+			return resp, nil
+		}
+		if !failsOver(ctx, path, requestMethod, err) {
+			return nil, err
+		}
+		f.setUnhealthy(i)
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// submitTo sends a request to a server within its timeout, which runs until the body of the
+// response is closed
+func (f *failover) submitTo(ctx context.Context, i int, path string, request interface{}, requestMethod string, encodeJSON bool, headers []*Header) (*http.Response, error) {
+	client := f.endpoints[i].client
+	timeout := client.timeout(path)
+	if timeout <= 0 {
+		return client.submitFormRaw(ctx, path, request, requestMethod, encodeJSON, headers)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	resp, err := client.submitFormRaw(ctx, path, request, requestMethod, encodeJSON, headers)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = cancelingBody{resp.Body, cancel}
+	return resp, nil
+}
+
+// cancelingBody cancels the context of a request once the body of its response is closed
+type cancelingBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// nodeAdministrationPaths are the prefixes of the requests administering the node they are
+// sent to, such as shutting it down, which are never failed over to another
+var nodeAdministrationPaths = []string{"/v2/shutdown", "/v2/catchup/", "/v2/register-participation-keys/"}
+
+// failsOver returns whether a request which failed with err on one server may be sent to the
+// next: a GET which may succeed there, or a transaction submission which could not connect
+// to the first server, so that it cannot be sent twice
+func failsOver(ctx context.Context, path string, requestMethod string, err error) bool {
+	for _, prefix := range nodeAdministrationPaths {
+		if strings.HasPrefix(path, prefix) {
+			return false
+		}
+	}
+	if !retryable(ctx, err) {
+		return false
+	}
+	switch {
+	case requestMethod == http.MethodGet:
+		return true
+	case requestMethod == http.MethodPost && path == "/v2/transactions":
+		return notConnected(err)
+	}
+	return false
+}
+
+// notConnected returns whether a request failed before a connection to the server was made
+func notConnected(err error) bool {
+	var opError *net.OpError
+	return errors.As(err, &opError) && opError.Op == "dial"
+}
+
+// checkHealth checks every server and moves away from the primary if it is not usable
+func (f *failover) checkHealth(ctx context.Context) error {
+	type result struct {
+		lastRound uint64
+		err       error
+	}
+	results := make([]result, len(f.endpoints))
+	for i, e := range f.endpoints {
+		lastRound, err := f.check(ctx, e.client)
+		results[i] = result{lastRound, err}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.checkedAt = time.Now()
+	healthy := 0
+	for i, e := range f.endpoints {
+		e.healthy = results[i].err == nil
+		if e.healthy {
+			e.lastRound = results[i].lastRound
+			healthy++
+		}
+	}
+	if !f.usable(f.primary) {
+		for i := range f.endpoints {
+			if f.usable(i) {
+				f.primary = i
+				break
+			}
+		}
+	}
+	if healthy == 0 {
+		return fmt.Errorf("none of the %d endpoints is healthy: %v", len(f.endpoints), results[0].err)
+	}
+	return nil
+}
+
+// candidates returns the servers to try for a request in order: the primary, the other
+// usable servers, and then the others as a last resort
+func (f *failover) candidates() []int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var order, others []int
+	if f.usable(f.primary) {
+		order = append(order, f.primary)
+	}
+	for i := range f.endpoints {
+		if i == f.primary && f.usable(i) {
+			continue
+		}
+		if f.usable(i) {
+			order = append(order, i)
+		} else {
+			others = append(others, i)
+		}
+	}
+	return append(order, others...)
+}
+
+// usable returns whether a server is healthy and at most maxLag rounds behind the most
+// advanced healthy server. f.mu must be held.
+func (f *failover) usable(i int) bool {
+	e := f.endpoints[i]
+	if !e.healthy {
+		return false
+	}
+	for _, other := range f.endpoints {
+		if other.healthy && other.lastRound > e.lastRound+f.maxLag {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *failover) setPrimary(i int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.primary = i
+	f.endpoints[i].healthy = true
+}
+
+func (f *failover) setUnhealthy(i int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.endpoints[i].healthy = false
+}
@@ -0,0 +1,186 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// stateTag is the struct tag naming the state key of a field, optionally followed by its
+// encoding: `teal:"key"`, `teal:"key,uint"`, `teal:"key,bytes"` or `teal:"key,address"`
+const stateTag = "teal"
+
+// Encodings of a state value in a struct field
+const (
+	uintEncoding    = "uint"
+	bytesEncoding   = "bytes"
+	addressEncoding = "address"
+)
+
+var addressType = reflect.TypeOf(types.Address{})
+
+// stateField is a struct field decoded from a state key
+type stateField struct {
+	key      string
+	encoding string
+	index    int
+}
+
+// Decode stores the state in the struct pointed to by v. Only the fields tagged with their
+// key are decoded, and those whose key is not set are left unchanged.
+//
+// A uint field decodes a uint value, a string, []byte or byte array field decodes a bytes
+// value, and a types.Address field decodes a 32 byte address. A string field tagged with
+// the address encoding decodes a 32 byte address into its string form.
+func (s TealState) Decode(v interface{}) error {
+	return StateDelta{Set: s}.Decode(v)
+}
+
+// Decode makes the change to the struct pointed to by v, as TealState.Decode does to a
+// struct decoded from the state changed. The fields of the keys deleted are set to zero.
+func (d StateDelta) Decode(v interface{}) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("can only decode state into a non-nil struct pointer, not %T", v)
+	}
+	value = value.Elem()
+	fields, err := stateFields(value.Type())
+	if err != nil {
+		return err
+	}
+
+	deleted := make(map[string]bool, len(d.Deleted))
+	for _, key := range d.Deleted {
+		deleted[key] = true
+	}
+	for _, field := range fields {
+		target := value.Field(field.index)
+		if deleted[field.key] {
+			target.Set(reflect.Zero(target.Type()))
+			continue
+		}
+		stateValue, ok := d.Set[field.key]
+		if !ok {
+			continue
+		}
+		if err := decodeStateValue(stateValue, field.encoding, target); err != nil {
+			return fmt.Errorf("state key %q into field %s: %v", field.key, value.Type().Field(field.index).Name, err)
+		}
+	}
+	return nil
+}
+
+// stateFields returns the tagged fields of a struct type, and the encoding of each
+func stateFields(t reflect.Type) ([]stateField, error) {
+	var fields []stateField
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		tag, ok := structField.Tag.Lookup(stateTag)
+		if !ok || tag == "-" {
+			continue
+		}
+		if structField.PkgPath != "" {
+			return nil, fmt.Errorf("field %s is tagged but not exported", structField.Name)
+		}
+		field := stateField{key: tag, index: i}
+		if comma := strings.IndexByte(tag, ','); comma >= 0 {
+			field.key, field.encoding = tag[:comma], tag[comma+1:]
+		}
+		if field.encoding == "" {
+			field.encoding = defaultEncoding(structField.Type)
+		}
+		if !encodable(structField.Type, field.encoding) {
+			return nil, fmt.Errorf("field %s of type %s cannot hold a %q value", structField.Name, structField.Type, field.encoding)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// defaultEncoding returns the encoding of a field type without one in its tag
+func defaultEncoding(t reflect.Type) string {
+	switch {
+	case t == addressType:
+		return addressEncoding
+	case isUint(t):
+		return uintEncoding
+	}
+	return bytesEncoding
+}
+
+// encodable returns whether a field type can hold values of an encoding
+func encodable(t reflect.Type, encoding string) bool {
+	switch encoding {
+	case uintEncoding:
+		return isUint(t)
+	case bytesEncoding:
+		return isBytes(t)
+	case addressEncoding:
+		return t == addressType || t.Kind() == reflect.String
+	}
+	return false
+}
+
+func isUint(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+func isBytes(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String:
+		return true
+	case reflect.Slice, reflect.Array:
+		return t.Elem().Kind() == reflect.Uint8
+	}
+	return false
+}
+
+// decodeStateValue stores a state value in a field of an encoding it can hold
+func decodeStateValue(value StateValue, encoding string, target reflect.Value) error {
+	if encoding == uintEncoding {
+		if value.Type != TealUintType {
+			return fmt.Errorf("value is not a uint")
+		}
+		if target.OverflowUint(value.Uint) {
+			return fmt.Errorf("%d overflows %s", value.Uint, target.Type())
+		}
+		target.SetUint(value.Uint)
+		return nil
+	}
+
+	if value.Type != TealBytesType {
+		return fmt.Errorf("value is not bytes")
+	}
+	if encoding == addressEncoding {
+		var address types.Address
+		if len(value.Bytes) != len(address) {
+			return fmt.Errorf("%d bytes are not an address", len(value.Bytes))
+		}
+		copy(address[:], value.Bytes)
+		if target.Kind() == reflect.String {
+			target.SetString(address.String())
+		} else {
+			target.Set(reflect.ValueOf(address))
+		}
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(string(value.Bytes))
+	case reflect.Slice:
+		target.SetBytes(append([]byte(nil), value.Bytes...))
+	case reflect.Array:
+		if len(value.Bytes) != target.Len() {
+			return fmt.Errorf("%d bytes do not fit %s", len(value.Bytes), target.Type())
+		}
+		reflect.Copy(target, reflect.ValueOf(value.Bytes))
+	}
+	return nil
+}
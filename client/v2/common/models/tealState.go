@@ -0,0 +1,164 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// TealValue types as set in TealValue.Type
+const (
+	TealBytesType = 1
+	TealUintType  = 2
+)
+
+// StateValue is a TealValue with its bytes decoded from base64
+type StateValue struct {
+	// Type is TealBytesType or TealUintType
+	Type  uint64
+	Bytes []byte
+	Uint  uint64
+}
+
+// TealState is application global or local state, keyed by the keys decoded from base64
+type TealState map[string]StateValue
+
+// DecodeTealValue decodes the bytes of a TealValue
+func DecodeTealValue(value TealValue) (StateValue, error) {
+	switch value.Type {
+	case TealBytesType:
+		raw, err := base64.StdEncoding.DecodeString(value.Bytes)
+		if err != nil {
+			return StateValue{}, fmt.Errorf("invalid bytes value: %v", err)
+		}
+		return StateValue{Type: TealBytesType, Bytes: raw}, nil
+	case TealUintType:
+		return StateValue{Type: TealUintType, Uint: value.Uint}, nil
+	}
+	return StateValue{}, fmt.Errorf("unknown value type %d", value.Type)
+}
+
+// DecodeTealState decodes the keys and values of application state
+func DecodeTealState(keyValues []TealKeyValue) (TealState, error) {
+	state := make(TealState, len(keyValues))
+	for _, kv := range keyValues {
+		key, err := base64.StdEncoding.DecodeString(kv.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state key %q: %v", kv.Key, err)
+		}
+		value, err := DecodeTealValue(kv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("state key %q: %v", key, err)
+		}
+		state[string(key)] = value
+	}
+	return state, nil
+}
+
+// DecodeGlobalState decodes the global state of an application, as fetched from algod or
+// the indexer
+func DecodeGlobalState(applicationId uint64, globalState []TealKeyValue) (TealState, error) {
+	state, err := DecodeTealState(globalState)
+	if err != nil {
+		return nil, fmt.Errorf("global state of application %d: %v", applicationId, err)
+	}
+	return state, nil
+}
+
+// DecodeLocalState decodes the local state of an account in an application from the
+// AppsLocalState of the account, and fails if it has not opted in to the application
+func DecodeLocalState(applicationId uint64, address string, localStates []ApplicationLocalState) (TealState, error) {
+	for _, local := range localStates {
+		if local.Id != applicationId {
+			continue
+		}
+		state, err := DecodeTealState(local.KeyValue)
+		if err != nil {
+			return nil, fmt.Errorf("local state of %s in application %d: %v", address, applicationId, err)
+		}
+		return state, nil
+	}
+	return nil, fmt.Errorf("%s has not opted in to application %d", address, applicationId)
+}
+
+// Uint returns the uint value of a key, and whether it is set to a uint
+func (s TealState) Uint(key string) (uint64, bool) {
+	value, ok := s[key]
+	if !ok || value.Type != TealUintType {
+		return 0, false
+	}
+	return value.Uint, true
+}
+
+// Bytes returns the bytes value of a key, and whether it is set to bytes
+func (s TealState) Bytes(key string) ([]byte, bool) {
+	value, ok := s[key]
+	if !ok || value.Type != TealBytesType {
+		return nil, false
+	}
+	return value.Bytes, true
+}
+
+// EvalDelta actions as set in EvalDelta.Action
+const (
+	DeltaSetBytesAction = 1
+	DeltaSetUintAction  = 2
+	DeltaDeleteAction   = 3
+)
+
+// StateDelta is a change to application state decoded from EvalDeltas: the values set by
+// key, and the keys deleted
+type StateDelta struct {
+	Set     TealState
+	Deleted []string
+}
+
+// DecodeStateDelta decodes the keys and values of a change to application state, such as
+// the GlobalDelta of a DryrunTxnResult or the GlobalStateDelta of a transaction
+func DecodeStateDelta(delta []EvalDeltaKeyValue) (StateDelta, error) {
+	decoded := StateDelta{Set: make(TealState)}
+	for _, kv := range delta {
+		key, err := base64.StdEncoding.DecodeString(kv.Key)
+		if err != nil {
+			return StateDelta{}, fmt.Errorf("invalid state key %q: %v", kv.Key, err)
+		}
+		switch kv.Value.Action {
+		case DeltaSetBytesAction:
+			value, err := DecodeTealValue(TealValue{Type: TealBytesType, Bytes: kv.Value.Bytes})
+			if err != nil {
+				return StateDelta{}, fmt.Errorf("state key %q: %v", key, err)
+			}
+			decoded.Set[string(key)] = value
+		case DeltaSetUintAction:
+			decoded.Set[string(key)] = StateValue{Type: TealUintType, Uint: kv.Value.Uint}
+		case DeltaDeleteAction:
+			decoded.Deleted = append(decoded.Deleted, string(key))
+		default:
+			return StateDelta{}, fmt.Errorf("state key %q: unknown delta action %d", key, kv.Value.Action)
+		}
+	}
+	return decoded, nil
+}
+
+// DecodeLocalStateDeltas decodes the changes to the local state of accounts, such as the
+// LocalDeltas of a DryrunTxnResult or the LocalStateDelta of a transaction, by address
+func DecodeLocalStateDeltas(deltas []AccountStateDelta) (map[string]StateDelta, error) {
+	decoded := make(map[string]StateDelta, len(deltas))
+	for _, delta := range deltas {
+		accountDelta, err := DecodeStateDelta(delta.Delta)
+		if err != nil {
+			return nil, fmt.Errorf("local state of %s: %v", delta.Address, err)
+		}
+		decoded[delta.Address] = accountDelta
+	}
+	return decoded, nil
+}
+
+// Apply makes the change to state
+func (d StateDelta) Apply(state TealState) {
+	for key, value := range d.Set {
+		state[key] = value
+	}
+	for _, key := range d.Deleted {
+		delete(state, key)
+	}
+}
@@ -0,0 +1,135 @@
+package models
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type auctionState struct {
+	Seller     types.Address `teal:"seller"`
+	Bidder     string        `teal:"bidder,address"`
+	Name       string        `teal:"name"`
+	Note       []byte        `teal:"note,bytes"`
+	Hash       [4]byte       `teal:"hash"`
+	Bid        uint64        `teal:"bid"`
+	Round      uint32        `teal:"round,uint"`
+	Untagged   uint64
+	Deliberate uint64 `teal:"-"`
+}
+
+func encodeKey(key string) string {
+	return base64.StdEncoding.EncodeToString([]byte(key))
+}
+
+func TestDecodeTealState(t *testing.T) {
+	seller := types.Address{1, 2, 3}
+	bidder := types.Address{4, 5, 6}
+	bytesValue := func(raw []byte) TealValue {
+		return TealValue{Type: TealBytesType, Bytes: base64.StdEncoding.EncodeToString(raw)}
+	}
+	state, err := DecodeTealState([]TealKeyValue{
+		{Key: encodeKey("seller"), Value: bytesValue(seller[:])},
+		{Key: encodeKey("bidder"), Value: bytesValue(bidder[:])},
+		{Key: encodeKey("name"), Value: bytesValue([]byte("lamp"))},
+		{Key: encodeKey("note"), Value: bytesValue([]byte("antique"))},
+		{Key: encodeKey("hash"), Value: bytesValue([]byte{9, 8, 7, 6})},
+		{Key: encodeKey("bid"), Value: TealValue{Type: TealUintType, Uint: 500}},
+		{Key: encodeKey("round"), Value: TealValue{Type: TealUintType, Uint: 12}},
+		{Key: encodeKey("Untagged"), Value: TealValue{Type: TealUintType, Uint: 1}},
+	})
+	require.NoError(t, err)
+
+	decoded := auctionState{Deliberate: 3}
+	require.NoError(t, state.Decode(&decoded))
+	require.Equal(t, auctionState{
+		Seller:     seller,
+		Bidder:     bidder.String(),
+		Name:       "lamp",
+		Note:       []byte("antique"),
+		Hash:       [4]byte{9, 8, 7, 6},
+		Bid:        500,
+		Round:      12,
+		Deliberate: 3,
+	}, decoded)
+
+	// a delta changes the fields of the keys set or deleted, and leaves the others
+	delta, err := DecodeStateDelta([]EvalDeltaKeyValue{
+		{Key: encodeKey("bid"), Value: EvalDelta{Action: DeltaSetUintAction, Uint: 600}},
+		{Key: encodeKey("bidder"), Value: EvalDelta{Action: DeltaSetBytesAction, Bytes: base64.StdEncoding.EncodeToString(seller[:])}},
+		{Key: encodeKey("note"), Value: EvalDelta{Action: DeltaDeleteAction}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"note"}, delta.Deleted)
+	require.NoError(t, delta.Decode(&decoded))
+	require.Equal(t, uint64(600), decoded.Bid)
+	require.Equal(t, seller.String(), decoded.Bidder)
+	require.Nil(t, decoded.Note)
+	require.Equal(t, "lamp", decoded.Name)
+
+	delta.Apply(state)
+	bid, _ := state.Uint("bid")
+	require.Equal(t, uint64(600), bid)
+	_, ok := state.Bytes("note")
+	require.False(t, ok)
+
+	locals, err := DecodeLocalStateDeltas([]AccountStateDelta{{Address: bidder.String(), Delta: []EvalDeltaKeyValue{
+		{Key: encodeKey("bid"), Value: EvalDelta{Action: DeltaSetUintAction, Uint: 1}},
+	}}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), locals[bidder.String()].Set["bid"].Uint)
+
+	// values that do not fit their fields
+	var mismatched struct {
+		Bid string `teal:"bid,address"`
+	}
+	require.Error(t, state.Decode(&mismatched))
+	var overflowing struct {
+		Bid uint8 `teal:"bid"`
+	}
+	require.Error(t, state.Decode(&overflowing))
+	var wrongType struct {
+		Bid []byte `teal:"bid"`
+	}
+	require.Error(t, state.Decode(&wrongType))
+	var badEncoding struct {
+		Bid bool `teal:"bid"`
+	}
+	require.Error(t, state.Decode(&badEncoding))
+	require.Error(t, state.Decode(decoded))
+
+	_, err = DecodeStateDelta([]EvalDeltaKeyValue{{Key: encodeKey("bid"), Value: EvalDelta{Action: 7}}})
+	require.Error(t, err)
+	_, err = DecodeTealState([]TealKeyValue{{Key: encodeKey("bid"), Value: TealValue{Type: 3}}})
+	require.Error(t, err)
+}
+
+func TestDecodeApplicationState(t *testing.T) {
+	const address = "47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU"
+	global, err := DecodeGlobalState(7, []TealKeyValue{
+		{Key: encodeKey("name"), Value: TealValue{Type: TealBytesType, Bytes: encodeKey("counter")}},
+		{Key: encodeKey("count"), Value: TealValue{Type: TealUintType, Uint: 3}},
+	})
+	require.NoError(t, err)
+	name, _ := global.Bytes("name")
+	require.Equal(t, []byte("counter"), name)
+	count, _ := global.Uint("count")
+	require.Equal(t, uint64(3), count)
+	_, err = DecodeGlobalState(7, []TealKeyValue{{Key: "!", Value: TealValue{Type: TealUintType}}})
+	require.Error(t, err)
+
+	localStates := []ApplicationLocalState{
+		{Id: 6, KeyValue: []TealKeyValue{{Key: encodeKey("count"), Value: TealValue{Type: TealUintType, Uint: 2}}}},
+		{Id: 7, KeyValue: []TealKeyValue{{Key: encodeKey("count"), Value: TealValue{Type: TealUintType, Uint: 1}}}},
+	}
+	local, err := DecodeLocalState(7, address, localStates)
+	require.NoError(t, err)
+	count, _ = local.Uint("count")
+	require.Equal(t, uint64(1), count)
+	_, err = DecodeLocalState(8, address, localStates)
+	require.EqualError(t, err, address+" has not opted in to application 8")
+	_, err = DecodeLocalState(7, address, []ApplicationLocalState{{Id: 7, KeyValue: []TealKeyValue{{Key: encodeKey("count"), Value: TealValue{Type: 3}}}}})
+	require.Error(t, err)
+}
@@ -0,0 +1,142 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultHTTPClient is shared by clients without their own, so that connections are reused
+var defaultHTTPClient = &http.Client{}
+
+// ClientOptions configures how a Client sends its requests. The zero value sends each
+// request once, without a timeout, through a shared http.Client.
+type ClientOptions struct {
+	// HTTPClient sends the requests, in place of the shared default
+	HTTPClient *http.Client
+	// Transport sends the requests through the shared default client's settings when
+	// HTTPClient is nil
+	Transport http.RoundTripper
+	// Timeout bounds each attempt at a request, including reading the response. It does not
+	// apply to StatusAfterBlock, which the node holds until a new round, for up to a minute.
+	Timeout time.Duration
+	// MaxRetries is the number of times a GET request is retried after a network error or
+	// a retryable response, a 5xx or 429 status
+	MaxRetries int
+	// MinBackoff is the wait before the first retry, doubled after each one
+	MinBackoff time.Duration
+	// MaxBackoff bounds the wait between retries, and a server's Retry-After
+	MaxBackoff time.Duration
+	// RequestHook is called with each request before it is sent, and may add headers to it
+	RequestHook func(req *http.Request)
+	// ResponseHook is called after each attempt with its response or error, and the time it
+	// took. It must not read the response body.
+	ResponseHook func(req *http.Request, resp *http.Response, err error, elapsed time.Duration)
+}
+
+// MakeClientWithOptions is the factory for constructing a Client for a given endpoint which
+// sends its requests as configured by options.
+func MakeClientWithOptions(address string, apiHeader, apiToken string, options ClientOptions) (c *Client, err error) {
+	c, err = MakeClient(address, apiHeader, apiToken)
+	if err != nil {
+		return
+	}
+
+	c.options = options
+
+	return
+}
+
+// httpClient returns the http.Client sending the client's requests
+func (client *Client) httpClient() *http.Client {
+	if client.options.HTTPClient != nil {
+		return client.options.HTTPClient
+	}
+	if client.options.Transport != nil {
+		return &http.Client{Transport: client.options.Transport}
+	}
+	return defaultHTTPClient
+}
+
+// send submits a request and decodes the body of its response, retrying GET requests
+// as configured by the client's options
+func (client *Client) send(ctx context.Context, path string, request interface{}, requestMethod string, encodeJSON bool, headers []*Header, decode func(body io.Reader) error) error {
+	backoff := client.options.MinBackoff
+	for attempt := 0; ; attempt++ {
+		sent, err := client.attempt(ctx, path, request, requestMethod, encodeJSON, headers, decode)
+		if err == nil || sent || requestMethod != "GET" || attempt >= client.options.MaxRetries || !retryable(ctx, err) {
+			return err
+		}
+
+		wait := backoff
+		var httpError HTTPError
+		if errors.As(err, &httpError) && httpError.RetryAfter > wait {
+			wait = httpError.RetryAfter
+		}
+		if client.options.MaxBackoff > 0 && wait > client.options.MaxBackoff {
+			wait = client.options.MaxBackoff
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+// attempt submits a request once, within the client's timeout, and decodes the body of its
+// response. sent is true once the request has succeeded, so that a decoding error is final.
+func (client *Client) attempt(ctx context.Context, path string, request interface{}, requestMethod string, encodeJSON bool, headers []*Header, decode func(body io.Reader) error) (sent bool, err error) {
+	if timeout := client.timeout(path); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	resp, err := client.submitFormRaw(ctx, path, request, requestMethod, encodeJSON, headers)
+	if err != nil {
+		return false, err
+	}
+
+	defer resp.Body.Close()
+	return true, decode(resp.Body)
+}
+
+// longPollPath is the prefix of the path of StatusAfterBlock requests, which wait for the node
+const longPollPath = "/v2/status/wait-for-block-after/"
+
+// timeout returns the timeout of an attempt at a request to path, or zero for none. A
+// failover client leaves it to each of its endpoints.
+func (client *Client) timeout(path string) time.Duration {
+	if client.failover != nil || strings.HasPrefix(path, longPollPath) {
+		return 0
+	}
+	return client.options.Timeout
+}
+
+// retryable returns whether a request which failed with err may succeed if sent again,
+// or to another server
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var httpError HTTPError
+	if errors.As(err, &httpError) {
+		return httpError.Retryable()
+	}
+	// the request did not get a response
+	return true
+}
+
+// parseRetryAfter returns the wait a Retry-After header in seconds asks for, or zero
+func parseRetryAfter(header string) time.Duration {
+	seconds, err := strconv.Atoi(header)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type countingTransport struct {
+	requests int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&t.requests, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientOptions(t *testing.T) {
+	var failures int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&failures, -1) >= 0 {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, `{"message":"busy"}`, http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"message":"ok"}`))
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/v2/status/wait-for-block-after/", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/rejected", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"bad"}`, http.StatusBadRequest)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	transport := &countingTransport{}
+	var hooked []string
+	client, err := MakeClientWithOptions(server.URL, "X-Algo-API-Token", "", ClientOptions{
+		Transport:  transport,
+		Timeout:    20 * time.Millisecond,
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+		RequestHook: func(req *http.Request) {
+			req.Header.Set("X-Request-Id", "42")
+		},
+		ResponseHook: func(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+			require.Equal(t, "42", req.Header.Get("X-Request-Id"))
+			if err != nil {
+				hooked = append(hooked, req.URL.Path+" error")
+				return
+			}
+			hooked = append(hooked, req.URL.Path+" "+resp.Status)
+		},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// GETs are retried, waiting at most MaxBackoff for a Retry-After
+	var response struct{ Message string }
+	atomic.StoreInt32(&failures, 2)
+	start := time.Now()
+	require.NoError(t, client.Get(ctx, &response, "/flaky", nil, nil))
+	require.Equal(t, "ok", response.Message)
+	require.True(t, time.Since(start) < time.Second)
+	require.Equal(t, []string{"/flaky 429 Too Many Requests", "/flaky 429 Too Many Requests", "/flaky 200 OK"}, hooked)
+	require.Equal(t, int32(3), atomic.LoadInt32(&transport.requests))
+
+	atomic.StoreInt32(&failures, 3)
+	err = client.Get(ctx, &response, "/flaky", nil, nil)
+	var httpError HTTPError
+	require.True(t, errors.As(err, &httpError))
+	require.Equal(t, time.Second, httpError.RetryAfter)
+
+	// other methods and errors are not retried
+	hooked = nil
+	atomic.StoreInt32(&failures, 1)
+	require.Error(t, client.Post(ctx, nil, "/flaky", nil, nil))
+	require.Error(t, client.Get(ctx, nil, "/rejected", nil, nil))
+	require.Equal(t, []string{"/flaky 429 Too Many Requests", "/rejected 400 Bad Request"}, hooked)
+
+	// each attempt times out
+	hooked = nil
+	err = client.Get(ctx, nil, "/slow", nil, nil)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Equal(t, []string{"/slow error", "/slow error", "/slow error"}, hooked)
+
+	// except for the long polls waiting for a round
+	hooked = nil
+	require.NoError(t, client.Get(ctx, nil, "/v2/status/wait-for-block-after/7", nil, nil))
+	require.Equal(t, []string{"/v2/status/wait-for-block-after/7 200 OK"}, hooked)
+}
@@ -12,3 +12,8 @@ func (c *Client) SearchForApplications() *SearchForApplications {
 	return &SearchForApplications{c: c}
 }
 
+// ApplicationState reads the global state of an application, and the local state of
+// accounts in it, decoded into Go values.
+func (c *Client) ApplicationState(applicationId uint64) *ApplicationState {
+	return &ApplicationState{c: c, applicationId: applicationId}
+}
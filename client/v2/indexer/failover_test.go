@@ -0,0 +1,35 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverClientHealth(t *testing.T) {
+	var endpoints []common.Endpoint
+	for _, round := range []uint64{90, 100} {
+		round := round
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/health", r.URL.Path)
+			fmt.Fprintf(w, `{"data":{"migration-required":false},"message":"%d","round":%d}`, round, round)
+		}))
+		defer server.Close()
+		endpoints = append(endpoints, common.Endpoint{Address: server.URL})
+	}
+	client, err := MakeFailoverClient(endpoints, common.FailoverOptions{MaxLag: 5})
+	require.NoError(t, err)
+
+	// the lagging indexer is passed over for the one which has imported more rounds
+	require.NoError(t, client.CheckHealth(context.Background()))
+	states := client.Endpoints()
+	require.Equal(t, uint64(90), states[0].LastRound)
+	require.Equal(t, uint64(100), states[1].LastRound)
+	require.True(t, states[1].Primary)
+}
@@ -22,6 +22,43 @@ func MakeClient(address string, apiToken string) (c *Client, err error) {
 	return
 }
 
+// MakeClientWithOptions is the factory for constructing a IndexerClient for a given endpoint which
+// sends its requests as configured by options.
+func MakeClientWithOptions(address string, apiToken string, options common.ClientOptions) (c *Client, err error) {
+	commonClient, err := common.MakeClientWithOptions(address, indexerAuthHeader, apiToken, options)
+	c = (*Client)(commonClient)
+	return
+}
+
+// MakeFailoverClient is the factory for constructing a Client for several indexers. Requests
+// go to one which is healthy and not lagging, as reported by their health, and fail
+// over to the others on errors.
+func MakeFailoverClient(endpoints []common.Endpoint, options common.FailoverOptions) (c *Client, err error) {
+	commonClient, err := common.MakeFailoverClient(endpoints, indexerAuthHeader, options, func(ctx context.Context, c *common.Client) (uint64, error) {
+		var health indexerHealth
+		err := c.Get(ctx, &health, "/health", nil, nil)
+		return health.Round, err
+	})
+	c = (*Client)(commonClient)
+	return
+}
+
+// indexerHealth is the part of the indexer's health response read by failover clients
+type indexerHealth struct {
+	// Round is the last round the indexer has imported
+	Round uint64 `json:"round"`
+}
+
+// CheckHealth checks the servers of a failover Client, and returns an error if none is healthy
+func (c *Client) CheckHealth(ctx context.Context) error {
+	return (*common.Client)(c).CheckHealth(ctx)
+}
+
+// Endpoints returns the state of the servers of a failover Client
+func (c *Client) Endpoints() []common.EndpointState {
+	return (*common.Client)(c).Endpoints()
+}
+
 func (c *Client) HealthCheck() *HealthCheck {
 	return &HealthCheck{c: c}
 }
@@ -0,0 +1,324 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// TransactionFilter selects transactions with the parameters of SearchForTransactions, so that
+// the same query can run on the indexer, with SearchForTransactions().Filter, or in memory on
+// the blocks of the chain, with Match and FilterBlock. All set parameters must match.
+// To select the transactions any of several filters match, pass them all to FilterBlock and
+// run a search for each on the indexer.
+type TransactionFilter struct {
+	applicationId       uint64
+	notePrefix          []byte
+	txType              string
+	sigType             string
+	txid                string
+	round               uint64
+	minRound            uint64
+	maxRound            uint64
+	assetId             uint64
+	beforeTime          time.Time
+	afterTime           time.Time
+	currencyGreaterThan uint64
+	currencyLessThan    uint64
+	address             types.Address
+	addressRole         string
+	excludeCloseTo      bool
+	rekeyTo             bool
+}
+
+// MakeTransactionFilter returns a filter matching every transaction
+func MakeTransactionFilter() *TransactionFilter {
+	return &TransactionFilter{}
+}
+
+// ApplicationId application ID
+func (f *TransactionFilter) ApplicationId(applicationId uint64) *TransactionFilter {
+	f.applicationId = applicationId
+	return f
+}
+
+// NotePrefix specifies a prefix which must be contained in the note field.
+func (f *TransactionFilter) NotePrefix(prefix []byte) *TransactionFilter {
+	f.notePrefix = prefix
+	return f
+}
+
+func (f *TransactionFilter) TxType(txtype string) *TransactionFilter {
+	f.txType = txtype
+	return f
+}
+
+// SigType filters just results using the specified type of signature:
+// * sig - Standard
+// * msig - MultiSig
+// * lsig - LogicSig
+func (f *TransactionFilter) SigType(sigtype string) *TransactionFilter {
+	f.sigType = sigtype
+	return f
+}
+
+// TXID lookup the specific transaction by ID.
+func (f *TransactionFilter) TXID(txid string) *TransactionFilter {
+	f.txid = txid
+	return f
+}
+
+// Round include results for the specified round.
+func (f *TransactionFilter) Round(rnd uint64) *TransactionFilter {
+	f.round = rnd
+	return f
+}
+
+// MinRound include results at or after the specified min-round.
+func (f *TransactionFilter) MinRound(min uint64) *TransactionFilter {
+	f.minRound = min
+	return f
+}
+
+// MaxRound include results at or before the specified max-round.
+func (f *TransactionFilter) MaxRound(max uint64) *TransactionFilter {
+	f.maxRound = max
+	return f
+}
+
+func (f *TransactionFilter) AssetID(index uint64) *TransactionFilter {
+	f.assetId = index
+	return f
+}
+
+// BeforeTime include results before the given time.
+func (f *TransactionFilter) BeforeTime(before time.Time) *TransactionFilter {
+	f.beforeTime = before
+	return f
+}
+
+// AfterTime include results after the given time.
+func (f *TransactionFilter) AfterTime(after time.Time) *TransactionFilter {
+	f.afterTime = after
+	return f
+}
+
+// CurrencyGreaterThan results should have an amount greater than this value.
+// MicroAlgos are the default currency unless an asset-id is provided, in which
+// case the asset will be used.
+func (f *TransactionFilter) CurrencyGreaterThan(greaterThan uint64) *TransactionFilter {
+	f.currencyGreaterThan = greaterThan
+	return f
+}
+
+// CurrencyLessThan results should have an amount less than this value. MicroAlgos
+// are the default currency unless an asset-id is provided, in which case the asset
+// will be used.
+func (f *TransactionFilter) CurrencyLessThan(lessThan uint64) *TransactionFilter {
+	f.currencyLessThan = lessThan
+	return f
+}
+
+// AddressRole combine with the address parameter to define what type of address to
+// search for: sender, receiver or freeze-target.
+func (f *TransactionFilter) AddressRole(role string) *TransactionFilter {
+	f.addressRole = role
+	return f
+}
+
+// Address only include transactions with this address in one of the transaction
+// fields.
+func (f *TransactionFilter) Address(address types.Address) *TransactionFilter {
+	f.address = address
+	return f
+}
+
+// ExcludeCloseTo combine with address and address-role parameters to define what
+// type of address to search for. The close to fields are normally treated as a
+// receiver, if you would like to exclude them set this parameter to true.
+func (f *TransactionFilter) ExcludeCloseTo(exclude bool) *TransactionFilter {
+	f.excludeCloseTo = exclude
+	return f
+}
+
+// RekeyTo include results which include the rekey-to field.
+func (f *TransactionFilter) RekeyTo(rekeyTo bool) *TransactionFilter {
+	f.rekeyTo = rekeyTo
+	return f
+}
+
+// Params returns the SearchForTransactions parameters of the filter
+func (f *TransactionFilter) Params() models.SearchForTransactionsParams {
+	p := models.SearchForTransactionsParams{
+		ApplicationId:       f.applicationId,
+		TxType:              f.txType,
+		SigType:             f.sigType,
+		TxId:                f.txid,
+		Round:               f.round,
+		MinRound:            f.minRound,
+		MaxRound:            f.maxRound,
+		AssetId:             f.assetId,
+		CurrencyGreaterThan: f.currencyGreaterThan,
+		CurrencyLessThan:    f.currencyLessThan,
+		AddressRole:         f.addressRole,
+		ExcludeCloseTo:      f.excludeCloseTo,
+		RekeyTo:             f.rekeyTo,
+	}
+	if len(f.notePrefix) > 0 {
+		p.NotePrefix = base64.StdEncoding.EncodeToString(f.notePrefix)
+	}
+	if !f.beforeTime.IsZero() {
+		p.BeforeTime = f.beforeTime.Format(time.RFC3339)
+	}
+	if !f.afterTime.IsZero() {
+		p.AfterTime = f.afterTime.Format(time.RFC3339)
+	}
+	if !f.address.IsZero() {
+		p.Address = f.address.String()
+	}
+	return p
+}
+
+// Match returns whether the filter matches a transaction. The round and time parameters
+// need the transaction's block and are ignored, see FilterBlock. The transaction ID is
+// computed from the transaction as it is, with its genesis ID and hash.
+func (f *TransactionFilter) Match(stxn types.SignedTxnWithAD) bool {
+	txn := stxn.Txn
+	if f.txType != "" && string(txn.Type) != f.txType {
+		return false
+	}
+	if f.sigType != "" && sigType(stxn.SignedTxn) != f.sigType {
+		return false
+	}
+	if f.txid != "" && crypto.TransactionIDString(txn) != f.txid {
+		return false
+	}
+	if f.applicationId != 0 && uint64(txn.ApplicationID) != f.applicationId {
+		return false
+	}
+	if f.assetId != 0 && !hasAsset(txn, f.assetId) {
+		return false
+	}
+	if f.currencyGreaterThan != 0 || f.currencyLessThan != 0 {
+		amount := uint64(txn.Amount)
+		if f.assetId != 0 {
+			amount = txn.AssetAmount
+		}
+		if f.currencyGreaterThan != 0 && amount <= f.currencyGreaterThan {
+			return false
+		}
+		if f.currencyLessThan != 0 && amount >= f.currencyLessThan {
+			return false
+		}
+	}
+	if len(f.notePrefix) > 0 && !bytes.HasPrefix(txn.Note, f.notePrefix) {
+		return false
+	}
+	if f.rekeyTo && txn.RekeyTo.IsZero() {
+		return false
+	}
+	if !f.address.IsZero() && !f.hasAddress(txn) {
+		return false
+	}
+	return true
+}
+
+// FilterBlock returns the transactions of block any of filters matches, in block order,
+// restoring the genesis ID and hash the block leaves out of them.
+func FilterBlock(block types.Block, filters ...*TransactionFilter) []types.SignedTxnWithAD {
+	round := uint64(block.Round)
+	timestamp := time.Unix(block.TimeStamp, 0)
+	var matched []types.SignedTxnWithAD
+	for _, stib := range block.Payset {
+		stxn := stib.SignedTxnWithAD
+		if stib.HasGenesisID {
+			stxn.Txn.GenesisID = block.GenesisID
+		}
+		if stib.HasGenesisHash {
+			stxn.Txn.GenesisHash = block.GenesisHash
+		}
+		for _, f := range filters {
+			if f.matchRound(round, timestamp) && f.Match(stxn) {
+				matched = append(matched, stxn)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// Filter sets the parameters of the search to those of filter, replacing any set before
+// other than Limit and NextToken
+func (s *SearchForTransactions) Filter(filter *TransactionFilter) *SearchForTransactions {
+	limit, next := s.p.Limit, s.p.NextToken
+	s.p = filter.Params()
+	s.p.Limit, s.p.NextToken = limit, next
+	return s
+}
+
+// matchRound returns whether the round and time parameters match a block
+func (f *TransactionFilter) matchRound(round uint64, timestamp time.Time) bool {
+	if f.round != 0 && round != f.round {
+		return false
+	}
+	if f.minRound != 0 && round < f.minRound {
+		return false
+	}
+	if f.maxRound != 0 && round > f.maxRound {
+		return false
+	}
+	if !f.beforeTime.IsZero() && !timestamp.Before(f.beforeTime) {
+		return false
+	}
+	if !f.afterTime.IsZero() && !timestamp.After(f.afterTime) {
+		return false
+	}
+	return true
+}
+
+// hasAddress returns whether the filter's address has its role in txn. Senders include
+// clawback senders, and receivers include close-to addresses unless they are excluded.
+func (f *TransactionFilter) hasAddress(txn types.Transaction) bool {
+	var addresses []types.Address
+	if f.addressRole == "" || f.addressRole == "sender" {
+		addresses = append(addresses, txn.Sender, txn.AssetSender)
+	}
+	if f.addressRole == "" || f.addressRole == "receiver" {
+		addresses = append(addresses, txn.Receiver, txn.AssetReceiver)
+		if !f.excludeCloseTo {
+			addresses = append(addresses, txn.CloseRemainderTo, txn.AssetCloseTo)
+		}
+	}
+	if f.addressRole == "" || f.addressRole == "freeze-target" {
+		addresses = append(addresses, txn.FreezeAccount)
+	}
+	for _, address := range addresses {
+		if address == f.address {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAsset returns whether txn configures, transfers or freezes the asset index
+func hasAsset(txn types.Transaction, index uint64) bool {
+	asset := types.AssetIndex(index)
+	return txn.ConfigAsset == asset || txn.XferAsset == asset || txn.FreezeAsset == asset
+}
+
+// sigType returns the sig-type of a signed transaction: sig, msig or lsig
+func sigType(stxn types.SignedTxn) string {
+	switch {
+	case len(stxn.Lsig.Logic) > 0:
+		return "lsig"
+	case len(stxn.Msig.Subsigs) > 0:
+		return "msig"
+	case stxn.Sig != types.Signature{}:
+		return "sig"
+	}
+	return ""
+}
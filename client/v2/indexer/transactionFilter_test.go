@@ -0,0 +1,74 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionFilter(t *testing.T) {
+	ours, err := types.DecodeAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	require.NoError(t, err)
+	other, err := types.DecodeAddress("W6UUUSEAOGLBHT7VFT4H2SDATKKSG6ZBUIJXTZMSLW36YS44FRP5NVAU7U")
+	require.NoError(t, err)
+
+	payment := types.Transaction{Type: types.PaymentTx}
+	payment.Sender, payment.Receiver, payment.Amount = other, ours, 5000
+	payment.Note = []byte("invoice:42")
+	closing := types.Transaction{Type: types.PaymentTx}
+	closing.Sender, closing.CloseRemainderTo = other, ours
+	transfer := types.Transaction{Type: types.AssetTransferTx}
+	transfer.Sender, transfer.AssetReceiver, transfer.XferAsset, transfer.AssetAmount = other, ours, 7, 100
+	call := types.Transaction{Type: types.ApplicationCallTx}
+	call.Sender, call.ApplicationID, call.RekeyTo = ours, 12, other
+
+	block := types.Block{}
+	block.Round, block.TimeStamp = 1000, 1600000000
+	block.GenesisID, block.GenesisHash = "testnet-v1.0", types.Digest{1}
+	for _, txn := range []types.Transaction{payment, closing, transfer, call} {
+		var stib types.SignedTxnInBlock
+		stib.Txn = txn
+		stib.Sig = types.Signature{1}
+		stib.HasGenesisID, stib.HasGenesisHash = true, true
+		block.Payset = append(block.Payset, stib)
+	}
+	txTypesOf := func(stxns []types.SignedTxnWithAD) (txTypes []string) {
+		for _, stxn := range stxns {
+			txTypes = append(txTypes, string(stxn.Txn.Type))
+		}
+		return
+	}
+
+	received := MakeTransactionFilter().Address(ours).AddressRole("receiver")
+	require.Equal(t, []string{"pay", "pay", "axfer"}, txTypesOf(FilterBlock(block, received)))
+	received.ExcludeCloseTo(true)
+	require.Equal(t, []string{"pay", "axfer"}, txTypesOf(FilterBlock(block, received)))
+
+	invoices := MakeTransactionFilter().TxType("pay").NotePrefix([]byte("invoice:")).CurrencyGreaterThan(1000)
+	assetTransfers := MakeTransactionFilter().AssetID(7).CurrencyLessThan(101)
+	appCalls := MakeTransactionFilter().ApplicationId(12).RekeyTo(true).SigType("sig")
+	matched := FilterBlock(block, invoices, assetTransfers, appCalls)
+	require.Equal(t, []string{"pay", "axfer", "appl"}, txTypesOf(matched))
+	require.Equal(t, "testnet-v1.0", matched[0].Txn.GenesisID)
+
+	byID := MakeTransactionFilter().TXID(crypto.TransactionIDString(matched[1].Txn))
+	require.Equal(t, []string{"axfer"}, txTypesOf(FilterBlock(block, byID)))
+
+	require.Empty(t, FilterBlock(block, MakeTransactionFilter().AssetID(7).CurrencyGreaterThan(100)))
+	require.Empty(t, FilterBlock(block, MakeTransactionFilter().SigType("lsig")))
+	require.Empty(t, FilterBlock(block, MakeTransactionFilter().MinRound(1001)))
+	require.Empty(t, FilterBlock(block, MakeTransactionFilter().AfterTime(time.Unix(1600000000, 0))))
+	require.Len(t, FilterBlock(block, MakeTransactionFilter().Round(1000).BeforeTime(time.Unix(1600000001, 0))), 4)
+
+	client, err := MakeClient("http://localhost", "")
+	require.NoError(t, err)
+	search := client.SearchForTransactions().Limit(10).Filter(invoices)
+	require.Equal(t, "pay", search.p.TxType)
+	require.Equal(t, "aW52b2ljZTo=", search.p.NotePrefix)
+	require.Equal(t, uint64(1000), search.p.CurrencyGreaterThan)
+	require.Equal(t, uint64(10), search.p.Limit)
+	require.Equal(t, ours.String(), received.Params().Address)
+}
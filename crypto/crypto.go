@@ -605,6 +605,12 @@ func TealSign(sk ed25519.PrivateKey, data []byte, contractAddress types.Address)
 	return
 }
 
+// VerifyTealSign checks a signature created by TealSign, as the ed25519verify opcode does
+func VerifyTealSign(pk ed25519.PublicKey, data []byte, contractAddress types.Address, rawSig types.Signature) bool {
+	msgParts := [][]byte{programDataPrefix, contractAddress[:], data}
+	return ed25519.Verify(pk, bytes.Join(msgParts, nil), rawSig[:])
+}
+
 // TealSignFromProgram creates a signature compatible with ed25519verify opcode from raw program bytes
 func TealSignFromProgram(sk ed25519.PrivateKey, data []byte, program []byte) (rawSig types.Signature, err error) {
 	addr := AddressFromProgram(program)
@@ -333,4 +333,6 @@ func TestTealSign(t *testing.T) {
 	msg := bytes.Join([][]byte{programDataPrefix, addr[:], data}, nil)
 	verified := ed25519.Verify(pk, msg, sig1[:])
 	require.True(t, verified)
+	require.True(t, VerifyTealSign(pk, data, addr, sig1))
+	require.False(t, VerifyTealSign(pk, data[1:], addr, sig1))
 }
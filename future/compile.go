@@ -0,0 +1,31 @@
+package future
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/logic"
+)
+
+// SourceMapForCompile returns a source map relating the pcs of a program algod compiled
+// with TealCompile back to the TEAL source it was given.
+// algod does not return source maps, so the source is assembled locally; the source map is
+// only returned if the local assembly is identical to the program algod produced.
+func SourceMapForCompile(source []byte, response models.CompileResponse) (sourceMap logic.SourceMap, err error) {
+	compiled, err := base64.StdEncoding.DecodeString(response.Result)
+	if err != nil {
+		err = fmt.Errorf("could not decode compiled program: %v", err)
+		return
+	}
+	program, assembledMap, err := logic.Assemble(string(source))
+	if err != nil {
+		return
+	}
+	if !bytes.Equal(program, compiled) {
+		err = fmt.Errorf("local assembly does not match the program compiled by algod")
+		return
+	}
+	return assembledMap, nil
+}
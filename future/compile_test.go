@@ -0,0 +1,28 @@
+package future
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/logic"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceMapForCompile(t *testing.T) {
+	source := []byte("#pragma version 2\nint 1\ndup\nbtoi")
+	response := models.CompileResponse{Result: base64.StdEncoding.EncodeToString([]byte{0x02, 0x20, 0x01, 0x01, 0x22, 0x49, 0x17})}
+	sourceMap, err := SourceMapForCompile(source, response)
+	require.NoError(t, err)
+
+	// a dryrun of the program fails on btoi
+	failure := DryrunFailure{Pc: 6, Line: 4, Source: "btoi", Error: "btoi arg is not bytes"}
+	location, ok := failure.SourceLocation(sourceMap)
+	require.True(t, ok)
+	require.Equal(t, logic.SourceLocation{Line: 3, Column: 0}, location)
+
+	response.Result = base64.StdEncoding.EncodeToString([]byte{0x02, 0x20, 0x01, 0x02, 0x22, 0x49, 0x17})
+	sourceMap, err = SourceMapForCompile(source, response)
+	require.EqualError(t, err, "local assembly does not match the program compiled by algod")
+	require.Equal(t, logic.SourceMap{}, sourceMap)
+}
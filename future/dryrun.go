@@ -0,0 +1,341 @@
+package future
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/logic"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// CreateDryrun builds a DryrunRequest for the given transactions from the live state of the
+// network algod is attached to.
+// The request holds the account state of every sender, every account referenced by an
+// application call, and the creators of every referenced application and asset, along with
+// the parameters and global state of every referenced application.
+// Round, LatestTimestamp and ProtocolVersion are taken from the last round algod has seen.
+func CreateDryrun(ctx context.Context, client *algod.Client, txns []types.SignedTxn) (request models.DryrunRequest, err error) {
+	request.Txns = txns
+
+	var accounts []types.Address
+	var apps []types.AppIndex
+	var assets []types.AssetIndex
+	for _, stxn := range txns {
+		accounts = append(accounts, stxn.Txn.Sender)
+		if stxn.Txn.Type != types.ApplicationCallTx {
+			continue
+		}
+		accounts = append(accounts, stxn.Txn.Accounts...)
+		if stxn.Txn.ApplicationID != 0 {
+			apps = append(apps, stxn.Txn.ApplicationID)
+		}
+		apps = append(apps, stxn.Txn.ForeignApps...)
+		assets = append(assets, stxn.Txn.ForeignAssets...)
+	}
+
+	seenApps := make(map[types.AppIndex]bool)
+	for _, appID := range apps {
+		if seenApps[appID] {
+			continue
+		}
+		seenApps[appID] = true
+		app, appErr := client.GetApplicationByID(uint64(appID)).Do(ctx)
+		if appErr != nil {
+			err = fmt.Errorf("could not fetch application %d: %v", appID, appErr)
+			return
+		}
+		request.Apps = append(request.Apps, app)
+		creator, decodeErr := types.DecodeAddress(app.Params.Creator)
+		if decodeErr != nil {
+			err = fmt.Errorf("application %d has an invalid creator: %v", appID, decodeErr)
+			return
+		}
+		accounts = append(accounts, creator)
+	}
+
+	seenAssets := make(map[types.AssetIndex]bool)
+	for _, assetID := range assets {
+		if seenAssets[assetID] {
+			continue
+		}
+		seenAssets[assetID] = true
+		asset, assetErr := client.GetAssetByID(uint64(assetID)).Do(ctx)
+		if assetErr != nil {
+			err = fmt.Errorf("could not fetch asset %d: %v", assetID, assetErr)
+			return
+		}
+		creator, decodeErr := types.DecodeAddress(asset.Params.Creator)
+		if decodeErr != nil {
+			err = fmt.Errorf("asset %d has an invalid creator: %v", assetID, decodeErr)
+			return
+		}
+		accounts = append(accounts, creator)
+	}
+
+	seenAccounts := make(map[types.Address]bool)
+	for _, address := range accounts {
+		if address.IsZero() || seenAccounts[address] {
+			continue
+		}
+		seenAccounts[address] = true
+		account, accountErr := client.AccountInformation(address.String()).Do(ctx)
+		if accountErr != nil {
+			err = fmt.Errorf("could not fetch account %s: %v", address.String(), accountErr)
+			return
+		}
+		request.Accounts = append(request.Accounts, account)
+	}
+
+	status, err := client.Status().Do(ctx)
+	if err != nil {
+		return
+	}
+	block, err := client.Block(status.LastRound).Do(ctx)
+	if err != nil {
+		return
+	}
+	request.Round = status.LastRound
+	request.LatestTimestamp = uint64(block.TimeStamp)
+	request.ProtocolVersion = status.LastVersion
+	return
+}
+
+// SaveDryrunRequest writes a msgpack encoded DryrunRequest to a file, so it can be replayed
+// with LoadDryrunRequest or passed to `goal clerk dryrun-remote -D`
+func SaveDryrunRequest(request models.DryrunRequest, filename string) error {
+	return ioutil.WriteFile(filename, msgpack.Encode(&request), 0644)
+}
+
+// LoadDryrunRequest reads a DryrunRequest previously written by SaveDryrunRequest
+func LoadDryrunRequest(filename string) (request models.DryrunRequest, err error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+	err = msgpack.Decode(data, &request)
+	return
+}
+
+// DryrunResponse is a models.DryrunResponse with inspection helpers for each transaction
+type DryrunResponse struct {
+	Error           string
+	ProtocolVersion string
+	Txns            []DryrunTxnResult
+}
+
+// NewDryrunResponse wraps the response of a TealDryrun request
+func NewDryrunResponse(response models.DryrunResponse) DryrunResponse {
+	result := DryrunResponse{
+		Error:           response.Error,
+		ProtocolVersion: response.ProtocolVersion,
+		Txns:            make([]DryrunTxnResult, len(response.Txns)),
+	}
+	for i, txn := range response.Txns {
+		result.Txns[i] = DryrunTxnResult{txn}
+	}
+	return result
+}
+
+// NewDryrunResponseFromJSON wraps a JSON encoded TealDryrun response, such as one saved from a CI log
+func NewDryrunResponseFromJSON(data []byte) (DryrunResponse, error) {
+	var response models.DryrunResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return DryrunResponse{}, err
+	}
+	return NewDryrunResponse(response), nil
+}
+
+// DryrunTxnResult is a models.DryrunTxnResult with inspection helpers
+type DryrunTxnResult struct {
+	models.DryrunTxnResult
+}
+
+// DryrunFailure locates the point at which a program stopped without approving
+type DryrunFailure struct {
+	// Pc is the program counter of the last instruction evaluated
+	Pc uint64
+	// Line is the line of the disassembly holding that instruction
+	Line uint64
+	// Source is the disassembled instruction, empty if the line is not in the disassembly
+	Source string
+	// Error is the evaluation error, empty if the program ran to completion but rejected
+	Error string
+}
+
+// SourceLocation resolves the failing pc to the TEAL source the program was assembled from
+func (f DryrunFailure) SourceLocation(sourceMap logic.SourceMap) (logic.SourceLocation, bool) {
+	return sourceMap.GetLocationForPc(int(f.Pc))
+}
+
+const dryrunRejectMessage = "REJECT"
+
+func rejected(messages []string) bool {
+	for _, message := range messages {
+		if message == dryrunRejectMessage {
+			return true
+		}
+	}
+	return false
+}
+
+// AppCallRejected reports whether the application call program rejected the transaction
+func (r DryrunTxnResult) AppCallRejected() bool {
+	return rejected(r.AppCallMessages)
+}
+
+// LogicSigRejected reports whether the logic signature program rejected the transaction
+func (r DryrunTxnResult) LogicSigRejected() bool {
+	return rejected(r.LogicSigMessages)
+}
+
+// Passed reports whether every program evaluated for the transaction approved it
+func (r DryrunTxnResult) Passed() bool {
+	_, appFailed := r.AppCallFailure()
+	_, lsigFailed := r.LogicSigFailure()
+	return !appFailed && !lsigFailed
+}
+
+// AppCallFailure returns where the application call program failed, if it did
+func (r DryrunTxnResult) AppCallFailure() (DryrunFailure, bool) {
+	return r.failure(r.AppCallTrace, r.AppCallRejected())
+}
+
+// LogicSigFailure returns where the logic signature program failed, if it did
+func (r DryrunTxnResult) LogicSigFailure() (DryrunFailure, bool) {
+	return r.failure(r.LogicSigTrace, r.LogicSigRejected())
+}
+
+func (r DryrunTxnResult) failure(trace []models.DryrunState, isRejected bool) (DryrunFailure, bool) {
+	if len(trace) == 0 {
+		return DryrunFailure{}, isRejected
+	}
+	last := trace[len(trace)-1]
+	if last.Error == "" && !isRejected {
+		return DryrunFailure{}, false
+	}
+	return DryrunFailure{
+		Pc:     last.Pc,
+		Line:   last.Line,
+		Source: r.sourceLine(last.Line),
+		Error:  last.Error,
+	}, true
+}
+
+func (r DryrunTxnResult) sourceLine(line uint64) string {
+	if line < uint64(len(r.Disassembly)) {
+		return r.Disassembly[line]
+	}
+	return ""
+}
+
+// GlobalStateDelta returns the decoded changes the application call made to global state
+func (r DryrunTxnResult) GlobalStateDelta() (models.StateDelta, error) {
+	return models.DecodeStateDelta(r.GlobalDelta)
+}
+
+// LocalStateDeltas returns the decoded changes the application call made to local state, keyed by address
+func (r DryrunTxnResult) LocalStateDeltas() (map[string]models.StateDelta, error) {
+	return models.DecodeLocalStateDeltas(r.LocalDeltas)
+}
+
+// StackPrinterConfig controls how TealValues are rendered in a dryrun trace
+type StackPrinterConfig struct {
+	// MaxValueWidth truncates rendered values to this many characters, 0 means no limit
+	MaxValueWidth int
+	// TopOfStackFirst renders the stack with its top on the left
+	TopOfStackFirst bool
+}
+
+// DefaultStackPrinterConfig is used by callers that have no rendering preferences
+var DefaultStackPrinterConfig = StackPrinterConfig{MaxValueWidth: 30, TopOfStackFirst: true}
+
+// GetAppCallTrace renders the application call trace as a table of pc, line, source, scratch and stack
+func (r DryrunTxnResult) GetAppCallTrace(config StackPrinterConfig) string {
+	return r.renderTrace(r.AppCallTrace, config)
+}
+
+// GetLogicSigTrace renders the logic signature trace as a table of pc, line, source, scratch and stack
+func (r DryrunTxnResult) GetLogicSigTrace(config StackPrinterConfig) string {
+	return r.renderTrace(r.LogicSigTrace, config)
+}
+
+func (r DryrunTxnResult) renderTrace(trace []models.DryrunState, config StackPrinterConfig) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "pc#\t|ln#\t|source\t|scratch\t|stack\t")
+	var previousScratch []models.TealValue
+	for _, state := range trace {
+		source := r.sourceLine(state.Line)
+		if state.Error != "" {
+			source = fmt.Sprintf("!! %s !!", state.Error)
+		}
+		fmt.Fprintf(w, "%d\t|%d\t|%s\t|%s\t|%s\t\n",
+			state.Pc, state.Line, source,
+			scratchChanges(previousScratch, state.Scratch, config),
+			stackToString(state.Stack, config))
+		previousScratch = state.Scratch
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// scratchChanges renders the scratch slots that differ from the previous step
+func scratchChanges(previous, current []models.TealValue, config StackPrinterConfig) string {
+	var changes []string
+	for i, value := range current {
+		if i < len(previous) && previous[i] == value {
+			continue
+		}
+		if i >= len(previous) && value == (models.TealValue{}) {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%d = %s", i, tealValueToString(value, config)))
+	}
+	return strings.Join(changes, ", ")
+}
+
+func stackToString(stack []models.TealValue, config StackPrinterConfig) string {
+	values := make([]string, len(stack))
+	for i, value := range stack {
+		values[i] = tealValueToString(value, config)
+	}
+	if config.TopOfStackFirst {
+		for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
+		}
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// tealValueToString renders uints in decimal and byte slices in hex
+func tealValueToString(value models.TealValue, config StackPrinterConfig) string {
+	var rendered string
+	switch value.Type {
+	case models.TealBytesType:
+		raw, err := base64.StdEncoding.DecodeString(value.Bytes)
+		if err != nil {
+			rendered = value.Bytes
+		} else {
+			rendered = "0x" + hex.EncodeToString(raw)
+		}
+	case models.TealUintType:
+		rendered = strconv.FormatUint(value.Uint, 10)
+	default:
+		rendered = ""
+	}
+	if config.MaxValueWidth > 0 && len(rendered) > config.MaxValueWidth {
+		rendered = rendered[:config.MaxValueWidth] + "..."
+	}
+	return rendered
+}
@@ -0,0 +1,203 @@
+package future
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dryrunSender = "47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU"
+const dryrunAppCreator = "PNWOET7LLOWMBMLE4KOCELCX6X3D3Q4H2Q4QJASYIEOF7YIPPQBG3YQ5YI"
+const dryrunOther = "W6UUUSEAOGLBHT7VFT4H2SDATKKSG6ZBUIJXTZMSLW36YS44FRP5NVAU7U"
+
+func mockAlgodForDryrun(t *testing.T, requested map[string]int) *httptest.Server {
+	writeJSON := func(w http.ResponseWriter, obj interface{}) {
+		assert.NoError(t, json.NewEncoder(w).Encode(obj))
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/accounts/", func(w http.ResponseWriter, r *http.Request) {
+		requested[r.URL.Path]++
+		writeJSON(w, models.Account{Address: filepath.Base(r.URL.Path), Amount: 1000000})
+	})
+	mux.HandleFunc("/v2/applications/", func(w http.ResponseWriter, r *http.Request) {
+		requested[r.URL.Path]++
+		writeJSON(w, models.Application{Id: 7, Params: models.ApplicationParams{Creator: dryrunAppCreator, ApprovalProgram: []byte{0x02, 0x20, 0x01, 0x01, 0x22}}})
+	})
+	mux.HandleFunc("/v2/assets/", func(w http.ResponseWriter, r *http.Request) {
+		requested[r.URL.Path]++
+		writeJSON(w, models.Asset{Index: 9, Params: models.AssetParams{Creator: dryrunSender, Total: 10}})
+	})
+	mux.HandleFunc("/v2/status", func(w http.ResponseWriter, r *http.Request) {
+		requested[r.URL.Path]++
+		writeJSON(w, models.NodeStatus{LastRound: 1234, LastVersion: "future"})
+	})
+	mux.HandleFunc("/v2/blocks/1234", func(w http.ResponseWriter, r *http.Request) {
+		requested[r.URL.Path]++
+		response := struct {
+			Block types.Block `codec:"block"`
+		}{}
+		response.Block.Round = 1234
+		response.Block.TimeStamp = 1600000000
+		w.Write(msgpack.Encode(response))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestCreateDryrun(t *testing.T) {
+	requested := make(map[string]int)
+	server := mockAlgodForDryrun(t, requested)
+	defer server.Close()
+	client, err := algod.MakeClient(server.URL, "")
+	require.NoError(t, err)
+
+	params := types.SuggestedParams{
+		Fee:             1000,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     byteFromBase64("JgsgCaCTqIaLeVhyL6XlRu3n7Rfk2FxMeK+wRSaQ7dI="),
+		FlatFee:         true,
+	}
+	senderAddr, err := types.DecodeAddress(dryrunSender)
+	require.NoError(t, err)
+	appCall, err := MakeApplicationNoOpTx(7, nil, []string{dryrunOther}, []uint64{7}, []uint64{9}, params, senderAddr, nil, types.Digest{}, [32]byte{}, types.Address{})
+	require.NoError(t, err)
+	payment, err := MakePaymentTxn(dryrunSender, dryrunOther, 10, nil, "", params)
+	require.NoError(t, err)
+	txns := []types.SignedTxn{{Txn: appCall}, {Txn: payment}}
+
+	request, err := CreateDryrun(context.Background(), client, txns)
+	require.NoError(t, err)
+	require.Equal(t, txns, request.Txns)
+	require.Equal(t, uint64(1234), request.Round)
+	require.Equal(t, uint64(1600000000), request.LatestTimestamp)
+	require.Equal(t, "future", request.ProtocolVersion)
+	require.Len(t, request.Apps, 1)
+	require.Equal(t, uint64(7), request.Apps[0].Id)
+
+	var addresses []string
+	for _, account := range request.Accounts {
+		addresses = append(addresses, account.Address)
+	}
+	require.Equal(t, []string{dryrunSender, dryrunOther, dryrunAppCreator}, addresses)
+	// every referenced resource is fetched exactly once
+	for path, count := range requested {
+		require.Equal(t, 1, count, path)
+	}
+
+	dir, err := ioutil.TempDir("", "dryrun")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "request.msgp")
+	require.NoError(t, SaveDryrunRequest(request, filename))
+	loaded, err := LoadDryrunRequest(filename)
+	require.NoError(t, err)
+	require.Equal(t, request, loaded)
+}
+
+func TestDryrunResponse(t *testing.T) {
+	const responseJSON = `{
+  "error": "",
+  "protocol-version": "future",
+  "txns": [
+    {
+      "app-call-messages": ["ApprovalProgram", "REJECT"],
+      "app-call-trace": [
+        {"line": 1, "pc": 1, "stack": []},
+        {"line": 2, "pc": 4, "stack": [{"type": 2, "uint": 5}]},
+        {"line": 3, "pc": 5, "stack": [{"type": 2, "uint": 5}, {"type": 2, "uint": 5}]},
+        {"line": 4, "pc": 6, "scratch": [{"type": 2, "uint": 5}], "stack": [{"type": 2, "uint": 5}]},
+        {"line": 5, "pc": 8, "scratch": [{"type": 2, "uint": 5}], "stack": [{"type": 2, "uint": 5}, {"type": 1, "bytes": "AQI="}]},
+        {"line": 6, "pc": 9, "scratch": [{"type": 2, "uint": 5}], "stack": [{"type": 2, "uint": 0}]}
+      ],
+      "disassembly": ["#pragma version 2", "intcblock 5", "intc_0", "dup", "store 0", "byte 0x0102", "=="],
+      "global-delta": [
+        {"key": "Y291bnQ=", "value": {"action": 2, "uint": 5}},
+        {"key": "bmFtZQ==", "value": {"action": 1, "bytes": "YWxnbw=="}},
+        {"key": "b2xk", "value": {"action": 3}}
+      ],
+      "local-deltas": [
+        {"address": "47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU", "delta": [{"key": "Y291bnQ=", "value": {"action": 2, "uint": 1}}]}
+      ]
+    },
+    {
+      "disassembly": ["#pragma version 2", "intcblock 1", "intc_0", "btoi"],
+      "logic-sig-messages": ["REJECT", "btoi arg is not bytes"],
+      "logic-sig-trace": [
+        {"line": 1, "pc": 1, "stack": []},
+        {"line": 2, "pc": 4, "stack": [{"type": 2, "uint": 1}]},
+        {"error": "btoi arg is not bytes", "line": 3, "pc": 5, "stack": [{"type": 2, "uint": 1}]}
+      ]
+    },
+    {
+      "disassembly": ["#pragma version 2", "intcblock 1", "intc_0"],
+      "logic-sig-messages": ["PASS"],
+      "logic-sig-trace": [
+        {"line": 1, "pc": 1, "stack": []},
+        {"line": 2, "pc": 4, "stack": [{"type": 2, "uint": 1}]}
+      ]
+    }
+  ]
+}`
+	response, err := NewDryrunResponseFromJSON([]byte(responseJSON))
+	require.NoError(t, err)
+	require.Equal(t, "future", response.ProtocolVersion)
+	require.Len(t, response.Txns, 3)
+
+	appCall := response.Txns[0]
+	require.True(t, appCall.AppCallRejected())
+	require.False(t, appCall.LogicSigRejected())
+	require.False(t, appCall.Passed())
+	failure, failed := appCall.AppCallFailure()
+	require.True(t, failed)
+	require.Equal(t, DryrunFailure{Pc: 9, Line: 6, Source: "=="}, failure)
+
+	global, err := appCall.GlobalStateDelta()
+	require.NoError(t, err)
+	require.Equal(t, models.StateDelta{
+		Set: models.TealState{
+			"count": {Type: models.TealUintType, Uint: 5},
+			"name":  {Type: models.TealBytesType, Bytes: []byte("algo")},
+		},
+		Deleted: []string{"old"},
+	}, global)
+	local, err := appCall.LocalStateDeltas()
+	require.NoError(t, err)
+	require.Equal(t, models.StateValue{Type: models.TealUintType, Uint: 1}, local[dryrunSender].Set["count"])
+
+	trace := appCall.GetAppCallTrace(StackPrinterConfig{TopOfStackFirst: true})
+	lines := strings.Split(strings.TrimSpace(trace), "\n")
+	require.Len(t, lines, 7)
+	require.Equal(t, "pc# |ln# |source      |scratch |stack", strings.TrimSpace(lines[0]))
+	require.Equal(t, "6   |4   |store 0     |0 = 5   |[5]", strings.TrimSpace(lines[4]))
+	require.Equal(t, "8   |5   |byte 0x0102 |        |[0x0102, 5]", strings.TrimSpace(lines[5]))
+
+	trace = appCall.GetAppCallTrace(StackPrinterConfig{MaxValueWidth: 3})
+	require.Contains(t, trace, "[5, 0x0...]")
+
+	lsigError := response.Txns[1]
+	require.True(t, lsigError.LogicSigRejected())
+	failure, failed = lsigError.LogicSigFailure()
+	require.True(t, failed)
+	require.Equal(t, DryrunFailure{Pc: 5, Line: 3, Source: "btoi", Error: "btoi arg is not bytes"}, failure)
+	require.Contains(t, lsigError.GetLogicSigTrace(DefaultStackPrinterConfig), "!! btoi arg is not bytes !!")
+
+	lsigPass := response.Txns[2]
+	require.True(t, lsigPass.Passed())
+	_, failed = lsigPass.LogicSigFailure()
+	require.False(t, failed)
+	_, failed = lsigPass.AppCallFailure()
+	require.False(t, failed)
+}
@@ -398,12 +398,14 @@ func transferAssetBuilder(account, recipient string, amount uint64, note []byte,
 
 	tx.AssetAmount = amount
 
-	// Update fee
-	eSize, err := transaction.EstimateSize(tx)
-	if err != nil {
-		return types.Transaction{}, err
+	if !params.FlatFee {
+		// Update fee
+		eSize, err := transaction.EstimateSize(tx)
+		if err != nil {
+			return types.Transaction{}, err
+		}
+		tx.Fee = types.MicroAlgos(eSize * uint64(params.Fee))
 	}
-	tx.Fee = types.MicroAlgos(eSize * uint64(params.Fee))
 
 	if tx.Fee < MinTxnFee {
 		tx.Fee = MinTxnFee
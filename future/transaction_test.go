@@ -727,3 +727,33 @@ func TestLogicSig(t *testing.T) {
 	require.True(t, verified)
 
 }
+
+func TestMakeAssetTransferTxnFlatFee(t *testing.T) {
+	const addr = "BH55E5RMBD4GYWXGX5W5PJ5JAHPGM5OXKDQH5DC4O2MGI7NW4H6VOE4CP4"
+	const genesisHash = "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI="
+	ghAsArray := byte32ArrayFromBase64(genesisHash)
+	params := types.SuggestedParams{
+		Fee:             2000,
+		FirstRoundValid: 322575,
+		LastRoundValid:  323575,
+		GenesisHash:     ghAsArray[:],
+		FlatFee:         true,
+	}
+
+	// a flat fee is used as is, rather than per byte
+	tx, err := MakeAssetTransferTxn(addr, addr, 1, nil, params, "", 1)
+	require.NoError(t, err)
+	require.Equal(t, types.MicroAlgos(2000), tx.Fee)
+	tx, err = MakeAssetAcceptanceTxn(addr, nil, params, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.MicroAlgos(2000), tx.Fee)
+	tx, err = MakeAssetRevocationTxn(addr, addr, 1, addr, nil, params, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.MicroAlgos(2000), tx.Fee)
+
+	// and raised to the minimum fee
+	params.Fee = 10
+	tx, err = MakeAssetTransferTxn(addr, addr, 1, nil, params, "", 1)
+	require.NoError(t, err)
+	require.Equal(t, types.MicroAlgos(MinTxnFee), tx.Fee)
+}
@@ -0,0 +1,682 @@
+package logic
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// assemblerDefaultVersion is the version of programs without a #pragma version line
+const assemblerDefaultVersion = 1
+
+// txnTypeNames are the named constants accepted by the int pseudo-op for TypeEnum values
+var txnTypeNames = []string{"unknown", "pay", "keyreg", "acfg", "axfer", "afrz", "appl"}
+
+// onCompletionNames are the named constants accepted by the int pseudo-op for OnCompletion values
+var onCompletionNames = []string{"NoOp", "OptIn", "CloseOut", "ClearState", "UpdateApplication", "DeleteApplication"}
+
+// immediateKind describes how an immediate argument of an operation is written in source
+type immediateKind int
+
+const (
+	immediateUint8 immediateKind = iota
+	immediateField
+	immediateLabel
+)
+
+// immediates lists the immediate arguments of every operation that has fixed size immediates
+var immediates = map[string][]immediateKind{
+	"intc":              {immediateUint8},
+	"bytec":             {immediateUint8},
+	"arg":               {immediateUint8},
+	"txn":               {immediateField},
+	"gtxn":              {immediateUint8, immediateField},
+	"txna":              {immediateField, immediateUint8},
+	"gtxna":             {immediateUint8, immediateField, immediateUint8},
+	"global":            {immediateField},
+	"load":              {immediateUint8},
+	"store":             {immediateUint8},
+	"substring":         {immediateUint8, immediateUint8},
+	"asset_holding_get": {immediateField},
+	"asset_params_get":  {immediateField},
+	"bnz":               {immediateLabel},
+	"bz":                {immediateLabel},
+	"b":                 {immediateLabel},
+}
+
+// intConstant is an entry of the intcblock collected by the int pseudo-op.
+// Template variables are kept apart from literal constants of the same value.
+type intConstant struct {
+	value    uint64
+	variable string
+}
+
+// byteConstant is an entry of the bytecblock collected by the byte and addr pseudo-ops
+type byteConstant struct {
+	value    []byte
+	variable string
+}
+
+// TemplateVariable is a TMPL_ prefixed placeholder left in a program by AssembleTemplate
+type TemplateVariable struct {
+	Name string
+	// Op is the pseudo-op the placeholder was used with: int, byte or addr
+	Op string
+	// Offset is the position of the placeholder's constant in the program:
+	// the varint of an int, or the length prefix of a byte or addr value
+	Offset int
+}
+
+// templateVariablePrefix marks the placeholders AssembleTemplate accepts in place of constants
+const templateVariablePrefix = "TMPL_"
+
+// labelReference is a branch whose offset is filled in once every label is known
+type labelReference struct {
+	line  int
+	pc    int
+	label string
+}
+
+// assembler holds the state of a single Assemble call. Instructions are
+// written to program; the constant blocks collected by the int, byte and addr
+// pseudo-ops are prepended once the whole source has been read.
+type assembler struct {
+	version       uint64
+	spec          *versionedSpec
+	program       bytes.Buffer
+	intc          []intConstant
+	bytec         []byteConstant
+	pseudoIntc    bool
+	pseudoBytec   bool
+	explicitIntc  bool
+	explicitBytec bool
+
+	labels          map[string]int
+	labelReferences []labelReference
+	pcToLocation    map[int]SourceLocation
+
+	// templates enables TMPL_ placeholders, recording the pseudo-op each is used with
+	templates         bool
+	variables         map[string]string
+	templateVariables []TemplateVariable
+}
+
+// Assemble compiles TEAL source into a program along with a source map relating
+// every instruction back to its source line and column. The source map's
+// Sources entry is left empty for the caller to name the source file.
+func Assemble(source string) (program []byte, sourceMap SourceMap, err error) {
+	asm := newAssembler(false)
+	program, err = asm.assemble(source)
+	if err != nil {
+		return
+	}
+	sourceMap = makeSourceMap(asm.pcToLocation, len(program))
+	return
+}
+
+// AssembleTemplate compiles TEAL source in which constants may be replaced by
+// TMPL_ prefixed placeholders: int TMPL_X, addr TMPL_X, byte TMPL_X or
+// byte base64 TMPL_X. Every placeholder gets its own constant block entry,
+// holding 0, the zero address or an empty byte string, and is returned with
+// its offset in the program so the value can be substituted later.
+func AssembleTemplate(source string) (program []byte, variables []TemplateVariable, err error) {
+	asm := newAssembler(true)
+	program, err = asm.assemble(source)
+	if err != nil {
+		return
+	}
+	variables = asm.templateVariables
+	return
+}
+
+func newAssembler(templates bool) *assembler {
+	return &assembler{
+		version:      assemblerDefaultVersion,
+		labels:       make(map[string]int),
+		pcToLocation: make(map[int]SourceLocation),
+		templates:    templates,
+		variables:    make(map[string]string),
+	}
+}
+
+// assemble compiles source, leaving pcToLocation relative to the start of the returned program
+func (asm *assembler) assemble(source string) (program []byte, err error) {
+	asm.spec, err = getSpec(asm.version)
+	if err != nil {
+		return
+	}
+
+	for line, text := range strings.Split(source, "\n") {
+		err = asm.assembleLine(line, text)
+		if err != nil {
+			err = fmt.Errorf("%d: %v", line+1, err)
+			return
+		}
+	}
+	err = asm.resolveLabels()
+	if err != nil {
+		return
+	}
+	if asm.pseudoIntc && asm.explicitIntc {
+		err = fmt.Errorf("int pseudo-op cannot be combined with intcblock")
+		return
+	}
+	if asm.pseudoBytec && asm.explicitBytec {
+		err = fmt.Errorf("byte and addr pseudo-ops cannot be combined with bytecblock")
+		return
+	}
+
+	header := asm.header()
+	program = append(header, asm.program.Bytes()...)
+	pcToLocation := make(map[int]SourceLocation, len(asm.pcToLocation))
+	for pc, location := range asm.pcToLocation {
+		pcToLocation[pc+len(header)] = location
+	}
+	asm.pcToLocation = pcToLocation
+	return
+}
+
+// header encodes the program version and the constant blocks collected by
+// pseudo-ops, recording where each template variable is placed
+func (asm *assembler) header() []byte {
+	var buf bytes.Buffer
+	varint := make([]byte, binary.MaxVarintLen64)
+	writeUvarint := func(value uint64) {
+		buf.Write(varint[:binary.PutUvarint(varint, value)])
+	}
+
+	writeUvarint(asm.version)
+	if len(asm.intc) > 0 {
+		buf.WriteByte(byte(asm.spec.names["intcblock"].Opcode))
+		writeUvarint(uint64(len(asm.intc)))
+		for _, constant := range asm.intc {
+			asm.recordVariable(constant.variable, buf.Len())
+			writeUvarint(constant.value)
+		}
+	}
+	if len(asm.bytec) > 0 {
+		buf.WriteByte(byte(asm.spec.names["bytecblock"].Opcode))
+		writeUvarint(uint64(len(asm.bytec)))
+		for _, constant := range asm.bytec {
+			asm.recordVariable(constant.variable, buf.Len())
+			writeUvarint(uint64(len(constant.value)))
+			buf.Write(constant.value)
+		}
+	}
+	return buf.Bytes()
+}
+
+func (asm *assembler) recordVariable(name string, offset int) {
+	if name != "" {
+		asm.templateVariables = append(asm.templateVariables, TemplateVariable{Name: name, Op: asm.variables[name], Offset: offset})
+	}
+}
+
+func (asm *assembler) assembleLine(line int, text string) error {
+	fields, columns, err := tokenize(text)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	if fields[0] == "#pragma" {
+		return asm.pragma(fields[1:])
+	}
+	if strings.HasSuffix(fields[0], ":") {
+		label := strings.TrimSuffix(fields[0], ":")
+		if _, ok := asm.labels[label]; ok {
+			return fmt.Errorf("duplicate label %s", label)
+		}
+		asm.labels[label] = asm.program.Len()
+		fields, columns = fields[1:], columns[1:]
+		if len(fields) == 0 {
+			return nil
+		}
+	}
+
+	asm.pcToLocation[asm.program.Len()] = SourceLocation{Line: line, Column: columns[0]}
+	name, args := fields[0], fields[1:]
+	switch name {
+	case "int":
+		return asm.assembleInt(args)
+	case "byte":
+		if variable, ok := asm.templateVariable(args); ok {
+			return asm.assembleByteVariable(name, variable, nil)
+		}
+		value, err := parseByteConstant(args)
+		if err != nil {
+			return err
+		}
+		return asm.assembleByte(byteConstant{value: value})
+	case "addr":
+		if len(args) != 1 {
+			return fmt.Errorf("addr expects one argument")
+		}
+		if variable, ok := asm.templateVariable(args); ok {
+			return asm.assembleByteVariable(name, variable, make([]byte, len(types.Address{})))
+		}
+		address, err := types.DecodeAddress(args[0])
+		if err != nil {
+			return err
+		}
+		return asm.assembleByte(byteConstant{value: address[:]})
+	case "arg":
+		if len(args) == 1 {
+			if index, err := strconv.ParseUint(args[0], 0, 8); err == nil && index < 4 {
+				return asm.emit(fmt.Sprintf("arg_%d", index))
+			}
+		}
+	case "txn":
+		if len(args) == 2 {
+			name = "txna"
+		}
+	case "gtxn":
+		if len(args) == 3 {
+			name = "gtxna"
+		}
+	case "intcblock":
+		asm.explicitIntc = true
+		return asm.assembleIntcBlock(args)
+	case "bytecblock":
+		asm.explicitBytec = true
+		return asm.assembleBytecBlock(args)
+	}
+	return asm.assembleOp(line, name, args)
+}
+
+func (asm *assembler) pragma(args []string) error {
+	if len(args) != 2 || args[0] != "version" {
+		return fmt.Errorf("unsupported pragma")
+	}
+	if asm.program.Len() > 0 || len(asm.labels) > 0 {
+		return fmt.Errorf("#pragma version is only allowed before instructions")
+	}
+	version, err := strconv.ParseUint(args[1], 0, 64)
+	if err != nil || version == 0 {
+		return fmt.Errorf("invalid version %s", args[1])
+	}
+	spec, err := getSpec(version)
+	if err != nil {
+		return fmt.Errorf("unsupported version %d", version)
+	}
+	asm.version, asm.spec = version, spec
+	return nil
+}
+
+// emit writes an operation that takes no immediate arguments
+func (asm *assembler) emit(name string) error {
+	op, ok := asm.spec.names[name]
+	if !ok {
+		return fmt.Errorf("%s is not available in version %d", name, asm.version)
+	}
+	asm.program.WriteByte(byte(op.Opcode))
+	return nil
+}
+
+// emitIndexed writes a reference to an intc or bytec constant, using the
+// single byte form for the first four constants
+func (asm *assembler) emitIndexed(name string, index int) error {
+	if index < 4 {
+		return asm.emit(fmt.Sprintf("%s_%d", name, index))
+	}
+	if index > 255 {
+		return fmt.Errorf("too many %s constants", name)
+	}
+	if err := asm.emit(name); err != nil {
+		return err
+	}
+	asm.program.WriteByte(byte(index))
+	return nil
+}
+
+// templateVariable returns the placeholder named by the arguments of an
+// int, byte or addr pseudo-op, if placeholders are enabled
+func (asm *assembler) templateVariable(args []string) (string, bool) {
+	if !asm.templates {
+		return "", false
+	}
+	if len(args) == 2 && (args[0] == "base64" || args[0] == "b64") {
+		args = args[1:]
+	}
+	if len(args) != 1 || !strings.HasPrefix(args[0], templateVariablePrefix) {
+		return "", false
+	}
+	return args[0], true
+}
+
+// useVariable checks a placeholder is always used with the same pseudo-op
+func (asm *assembler) useVariable(op, variable string) error {
+	if previous, ok := asm.variables[variable]; ok && previous != op {
+		return fmt.Errorf("%s is used with both %s and %s", variable, previous, op)
+	}
+	asm.variables[variable] = op
+	return nil
+}
+
+func (asm *assembler) assembleInt(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("int expects one argument")
+	}
+	var constant intConstant
+	if variable, ok := asm.templateVariable(args); ok {
+		if err := asm.useVariable("int", variable); err != nil {
+			return err
+		}
+		constant.variable = variable
+	} else {
+		value, err := parseIntConstant(args[0])
+		if err != nil {
+			return err
+		}
+		constant.value = value
+	}
+	asm.pseudoIntc = true
+	for i, existing := range asm.intc {
+		if existing == constant {
+			return asm.emitIndexed("intc", i)
+		}
+	}
+	asm.intc = append(asm.intc, constant)
+	return asm.emitIndexed("intc", len(asm.intc)-1)
+}
+
+func (asm *assembler) assembleByteVariable(op, variable string, placeholder []byte) error {
+	if err := asm.useVariable(op, variable); err != nil {
+		return err
+	}
+	return asm.assembleByte(byteConstant{value: placeholder, variable: variable})
+}
+
+func (asm *assembler) assembleByte(constant byteConstant) error {
+	asm.pseudoBytec = true
+	for i, existing := range asm.bytec {
+		if existing.variable == constant.variable && bytes.Equal(existing.value, constant.value) {
+			return asm.emitIndexed("bytec", i)
+		}
+	}
+	asm.bytec = append(asm.bytec, constant)
+	return asm.emitIndexed("bytec", len(asm.bytec)-1)
+}
+
+func (asm *assembler) assembleIntcBlock(args []string) error {
+	if err := asm.emit("intcblock"); err != nil {
+		return err
+	}
+	varint := make([]byte, binary.MaxVarintLen64)
+	asm.program.Write(varint[:binary.PutUvarint(varint, uint64(len(args)))])
+	for _, arg := range args {
+		value, err := parseIntConstant(arg)
+		if err != nil {
+			return err
+		}
+		asm.program.Write(varint[:binary.PutUvarint(varint, value)])
+	}
+	return nil
+}
+
+func (asm *assembler) assembleBytecBlock(args []string) error {
+	if err := asm.emit("bytecblock"); err != nil {
+		return err
+	}
+	var values [][]byte
+	for len(args) > 0 {
+		// encodings such as "base64 AAAA" span two fields
+		consumed := 1
+		if encodingNeedsValue(args[0]) {
+			consumed = 2
+		}
+		if consumed > len(args) {
+			return fmt.Errorf("%s needs a value", args[0])
+		}
+		value, err := parseByteConstant(args[:consumed])
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+		args = args[consumed:]
+	}
+	varint := make([]byte, binary.MaxVarintLen64)
+	asm.program.Write(varint[:binary.PutUvarint(varint, uint64(len(values)))])
+	for _, value := range values {
+		asm.program.Write(varint[:binary.PutUvarint(varint, uint64(len(value)))])
+		asm.program.Write(value)
+	}
+	return nil
+}
+
+// assembleOp writes an operation and its fixed size immediate arguments
+func (asm *assembler) assembleOp(line int, name string, args []string) error {
+	op, ok := asm.spec.names[name]
+	if !ok {
+		if _, known := lookupAnyVersion(name); known {
+			return fmt.Errorf("%s is not available in version %d", name, asm.version)
+		}
+		return fmt.Errorf("unknown opcode: %s", name)
+	}
+	kinds := immediates[name]
+	if op.Size == 0 {
+		return fmt.Errorf("%s cannot be assembled", name)
+	}
+	if len(args) != len(kinds) {
+		return fmt.Errorf("%s expects %d immediate arguments", name, len(kinds))
+	}
+
+	pc := asm.program.Len()
+	asm.program.WriteByte(byte(op.Opcode))
+	for i, kind := range kinds {
+		switch kind {
+		case immediateUint8:
+			value, err := strconv.ParseUint(args[i], 0, 8)
+			if err != nil {
+				return fmt.Errorf("%s immediate %s is not a uint8", name, args[i])
+			}
+			asm.program.WriteByte(byte(value))
+		case immediateField:
+			index := fieldIndex(op.ArgEnum, args[i])
+			if index >= 0 && (name == "txna" || name == "gtxna") {
+				// array fields are encoded by their position among all txn fields
+				index = fieldIndex(asm.spec.names["txn"].ArgEnum, args[i])
+			}
+			if index < 0 {
+				return fmt.Errorf("%s unknown field %s", name, args[i])
+			}
+			asm.program.WriteByte(byte(index))
+		case immediateLabel:
+			asm.labelReferences = append(asm.labelReferences, labelReference{line: line, pc: pc, label: args[i]})
+			asm.program.Write([]byte{0, 0})
+		}
+	}
+	return nil
+}
+
+// resolveLabels fills in the offsets of every branch
+func (asm *assembler) resolveLabels() error {
+	program := asm.program.Bytes()
+	for _, ref := range asm.labelReferences {
+		target, ok := asm.labels[ref.label]
+		if !ok {
+			return fmt.Errorf("%d: reference to undefined label %s", ref.line+1, ref.label)
+		}
+		offset := target - (ref.pc + 3)
+		if offset < 0 {
+			return fmt.Errorf("%d: label %s is a back reference, only forward branches are allowed", ref.line+1, ref.label)
+		}
+		if offset > 0x7fff {
+			return fmt.Errorf("%d: label %s is too far away", ref.line+1, ref.label)
+		}
+		binary.BigEndian.PutUint16(program[ref.pc+1:], uint16(offset))
+	}
+	return nil
+}
+
+// fieldIndex returns the position of field in fields, or -1 if it is not there
+func fieldIndex(fields []string, field string) int {
+	for i, f := range fields {
+		if f == field {
+			return i
+		}
+	}
+	return -1
+}
+
+// lookupAnyVersion finds an operation by name in any bundled version
+func lookupAnyVersion(name string) (Operation, bool) {
+	for _, vs := range specs {
+		if op, ok := vs.names[name]; ok {
+			return op, true
+		}
+	}
+	return Operation{}, false
+}
+
+// parseIntConstant parses an int pseudo-op argument: a decimal, hex or octal
+// number, or a named TypeEnum or OnCompletion constant
+func parseIntConstant(arg string) (uint64, error) {
+	for i, name := range txnTypeNames {
+		if arg == name {
+			return uint64(i), nil
+		}
+	}
+	for i, name := range onCompletionNames {
+		if arg == name {
+			return uint64(i), nil
+		}
+	}
+	value, err := strconv.ParseUint(arg, 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse %s as integer", arg)
+	}
+	return value, nil
+}
+
+// encodingNeedsValue reports whether a byte constant encoding is followed by a separate value field
+func encodingNeedsValue(encoding string) bool {
+	switch encoding {
+	case "base64", "b64", "base32", "b32":
+		return true
+	}
+	return false
+}
+
+// parseByteConstant parses the arguments of a byte pseudo-op: base64 or base32
+// values, 0x prefixed hex or a double quoted string
+func parseByteConstant(args []string) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("byte constant is missing")
+	}
+	arg := args[0]
+	if encodingNeedsValue(arg) {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("%s needs exactly one value", arg)
+		}
+		return decodeByteConstant(arg, args[1])
+	}
+	if len(args) != 1 {
+		return nil, fmt.Errorf("byte constant takes one value")
+	}
+	if open := strings.IndexByte(arg, '('); open > 0 && strings.HasSuffix(arg, ")") {
+		return decodeByteConstant(arg[:open], arg[open+1:len(arg)-1])
+	}
+	if strings.HasPrefix(arg, "0x") {
+		return hex.DecodeString(arg[2:])
+	}
+	if strings.HasPrefix(arg, "\"") {
+		return parseStringLiteral(arg)
+	}
+	return nil, fmt.Errorf("byte constant %s has an unknown encoding", arg)
+}
+
+func decodeByteConstant(encoding string, value string) ([]byte, error) {
+	switch encoding {
+	case "base64", "b64":
+		return base64.StdEncoding.DecodeString(value)
+	case "base32", "b32":
+		return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(value, "="))
+	}
+	return nil, fmt.Errorf("unknown byte constant encoding %s", encoding)
+}
+
+// parseStringLiteral decodes a double quoted string with \n, \r, \t, \\, \" and \xHH escapes
+func parseStringLiteral(literal string) ([]byte, error) {
+	if len(literal) < 2 || !strings.HasSuffix(literal, "\"") {
+		return nil, fmt.Errorf("unterminated string %s", literal)
+	}
+	body := literal[1 : len(literal)-1]
+	var result []byte
+	for i := 0; i < len(body); i++ {
+		if body[i] != '\\' {
+			result = append(result, body[i])
+			continue
+		}
+		i++
+		if i == len(body) {
+			return nil, fmt.Errorf("escape at end of string %s", literal)
+		}
+		switch body[i] {
+		case 'n':
+			result = append(result, '\n')
+		case 'r':
+			result = append(result, '\r')
+		case 't':
+			result = append(result, '\t')
+		case '\\', '"':
+			result = append(result, body[i])
+		case 'x':
+			if i+2 >= len(body) {
+				return nil, fmt.Errorf("short \\x escape in %s", literal)
+			}
+			value, err := hex.DecodeString(body[i+1 : i+3])
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value...)
+			i += 2
+		default:
+			return nil, fmt.Errorf("unknown escape \\%c in %s", body[i], literal)
+		}
+	}
+	return result, nil
+}
+
+// tokenize splits a source line into whitespace separated fields, keeping
+// double quoted strings whole and dropping // comments. It also returns the
+// zero-based column each field starts at.
+func tokenize(text string) (fields []string, columns []int, err error) {
+	for i := 0; i < len(text); {
+		switch {
+		case text[i] == ' ' || text[i] == '\t' || text[i] == '\r':
+			i++
+		case strings.HasPrefix(text[i:], "//"):
+			return
+		default:
+			start := i
+			for i < len(text) && text[i] != ' ' && text[i] != '\t' && text[i] != '\r' {
+				if text[i] == '"' {
+					i++
+					for i < len(text) && text[i] != '"' {
+						if text[i] == '\\' {
+							i++
+						}
+						i++
+					}
+					if i >= len(text) {
+						err = fmt.Errorf("unterminated string %s", text[start:])
+						return
+					}
+				}
+				i++
+			}
+			fields = append(fields, text[start:i])
+			columns = append(columns, start)
+		}
+	}
+	return
+}
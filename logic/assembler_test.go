@@ -0,0 +1,156 @@
+package logic
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// htlcSource is the hash time locked contract template with its placeholders filled in
+const htlcSource = `#pragma version 1
+txn Fee
+int 8
+<=
+txn TypeEnum
+int pay
+==
+&&
+txn Receiver
+global ZeroAddress
+==
+&&
+txn Amount
+int 0
+==
+&&
+txn CloseRemainderTo
+addr 42NJMHTPFVPXVSDGA6JGKUV6TARV5UZTMPFIREMLXHETRKIVW34QFSDFRE
+==
+arg 0
+sha256
+byte base64 f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=
+==
+&&
+txn CloseRemainderTo
+byte 0xfebca0bb144a5a4ea7b438a4681ac80e6ca105bcb607fc565325c95695f6a213
+==
+txn FirstValid
+int 9 // timeout
+>
+&&
+||
+&&`
+
+func TestAssemble(t *testing.T) {
+	program, _, err := Assemble(htlcSource)
+	require.NoError(t, err)
+	require.Equal(t, "ASAECAEACSYDIOaalh5vLV96yGYHkmVSvpgjXtMzY8qIkYu5yTipFbb5IH+DsWV/8fxTuS3BgUih1l38LUsfo9Z3KErd0gASbZBpIP68oLsUSlpOp7Q4pGgayA5soQW8tgf8VlMlyVaV9qITMQEiDjEQIxIQMQcyAxIQMQgkEhAxCSgSLQEpEhAxCSoSMQIlDRAREA==",
+		base64.StdEncoding.EncodeToString(program))
+	require.NoError(t, CheckProgram(program, nil))
+
+	program, _, err = Assemble(`#pragma version 2
+byte "a \"b\"\x01" // strings keep their spaces
+byte b64(AQ==)
+txn ApplicationArgs 1
+gtxn 1 Accounts 2
+int OptIn
+arg 4
+bz end
+int 1
+end:`)
+	require.NoError(t, err)
+	require.Equal(t, []byte{
+		0x02,
+		0x20, 0x01, 0x01, // intcblock 1, shared by OptIn and 1
+		0x26, 0x02, 0x06, 'a', ' ', '"', 'b', '"', 0x01, 0x01, 0x01,
+		0x28, 0x29, // bytec_0 bytec_1
+		0x36, 0x1a, 0x01, // txna ApplicationArgs 1
+		0x37, 0x01, 0x1c, 0x02, // gtxna 1 Accounts 2
+		0x22,       // intc_0
+		0x2c, 0x04, // arg 4
+		0x41, 0x00, 0x01, // bz end
+		0x22, // intc_0
+	}, program)
+}
+
+func TestAssembleErrors(t *testing.T) {
+	_, _, err := Assemble("int 1\nfoo")
+	require.EqualError(t, err, "2: unknown opcode: foo")
+
+	_, _, err = Assemble("int 1\napp_global_get")
+	require.EqualError(t, err, "2: app_global_get is not available in version 1")
+
+	_, _, err = Assemble("#pragma version 9")
+	require.EqualError(t, err, "1: unsupported version 9")
+
+	_, _, err = Assemble("int 1\n#pragma version 2")
+	require.EqualError(t, err, "2: #pragma version is only allowed before instructions")
+
+	_, _, err = Assemble("int 1\nbnz nowhere")
+	require.EqualError(t, err, "2: reference to undefined label nowhere")
+
+	_, _, err = Assemble("top:\nint 1\nbnz top")
+	require.EqualError(t, err, "3: label top is a back reference, only forward branches are allowed")
+
+	_, _, err = Assemble("txn NotAField")
+	require.EqualError(t, err, "1: txn unknown field NotAField")
+
+	_, _, err = Assemble(`byte "open`)
+	require.EqualError(t, err, `1: unterminated string "open`)
+
+	_, _, err = Assemble("intcblock 1\nint 2")
+	require.EqualError(t, err, "int pseudo-op cannot be combined with intcblock")
+}
+
+func TestSourceMap(t *testing.T) {
+	program, sourceMap, err := Assemble(`#pragma version 2
+// comment
+int 1
+  txn Fee // indented
+<=
+bnz done
+err
+done:
+int 1`)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x02, 0x20, 0x01, 0x01, 0x22, 0x31, 0x01, 0x0e, 0x40, 0x00, 0x01, 0x00, 0x22}, program)
+	require.Equal(t, ";;;;AAEA;AACE;;AACF;AACA;;;AACA;AAEA", sourceMap.Mappings)
+
+	location, ok := sourceMap.GetLocationForPc(5)
+	require.True(t, ok)
+	require.Equal(t, SourceLocation{Line: 3, Column: 2}, location)
+	require.Equal(t, "4:3", location.String())
+	_, ok = sourceMap.GetLocationForPc(6)
+	require.False(t, ok)
+	_, ok = sourceMap.GetLocationForPc(2)
+	require.False(t, ok)
+	require.Equal(t, []int{4}, sourceMap.GetPcsForLine(2))
+	require.Empty(t, sourceMap.GetPcsForLine(1))
+
+	sourceMap.Sources = []string{"approval.teal"}
+	encoded, err := json.Marshal(sourceMap)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"version":3,"sources":["approval.teal"],"names":[],"mappings":";;;;AAEA;AACE;;AACF;AACA;;;AACA;AAEA"}`, string(encoded))
+
+	decoded, err := DecodeSourceMap(encoded)
+	require.NoError(t, err)
+	require.Equal(t, sourceMap, decoded)
+
+	_, err = DecodeSourceMap([]byte(`{"version":2,"sources":[],"names":[],"mappings":""}`))
+	require.EqualError(t, err, "unsupported source map version 2")
+	_, err = DecodeSourceMap([]byte(`{"version":3,"sources":[],"names":[],"mappings":"AA!A"}`))
+	require.EqualError(t, err, `mapping for pc 0: invalid character '!'`)
+}
+
+func TestVLQ(t *testing.T) {
+	for _, value := range []int{0, 1, -1, 15, 16, -16, 1000, -123456} {
+		decoded, err := decodeVLQ(encodeVLQ(value))
+		require.NoError(t, err)
+		require.Equal(t, []int{value}, decoded)
+	}
+	require.Equal(t, "AAgBC", encodeVLQ(0, 0, 16, 1))
+	_, err := decodeVLQ("g")
+	require.EqualError(t, err, "truncated value")
+}
@@ -6,31 +6,166 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/algorand/go-algorand-sdk/types"
 )
 
-type langSpec struct {
+// LangSpec describes the TEAL language at a single version
+type LangSpec struct {
 	EvalMaxVersion  int
 	LogicSigVersion int
-	Ops             []operation
+	Ops             []Operation
 }
 
-type operation struct {
+// Operation describes a single TEAL opcode: its encoding, cost, stack effects,
+// immediate arguments and documentation
+type Operation struct {
 	Opcode        int
 	Name          string
+	Args          string
+	Returns       string
 	Cost          int
 	Size          int
-	Returns       string
 	ArgEnum       []string
 	ArgEnumTypes  string
 	Doc           string
+	DocExtra      string
 	ImmediateNote string
-	Group         []string
+	Group         []string `json:"Groups"`
+}
+
+// versionedSpec is a parsed langspec along with its lookup tables
+type versionedSpec struct {
+	spec    LangSpec
+	opcodes [256]Operation
+	names   map[string]Operation
+}
+
+var specs map[uint64]*versionedSpec
+var specsMaxVersion uint64
+var specsErr error
+var specsOnce sync.Once
+
+// loadSpecs parses every bundled langspec once
+func loadSpecs() error {
+	specsOnce.Do(func() {
+		parsed := make(map[uint64]*versionedSpec, len(langSpecJson))
+		for version, specJSON := range langSpecJson {
+			vs := &versionedSpec{names: make(map[string]Operation)}
+			if err := json.Unmarshal(specJSON, &vs.spec); err != nil {
+				specsErr = fmt.Errorf("could not parse langspec for version %d: %v", version, err)
+				return
+			}
+			for _, op := range vs.spec.Ops {
+				vs.opcodes[op.Opcode] = op
+				vs.names[op.Name] = op
+			}
+			parsed[version] = vs
+			if version > specsMaxVersion {
+				specsMaxVersion = version
+			}
+		}
+		specs = parsed
+	})
+	return specsErr
+}
+
+// getSpec returns the parsed langspec for the given program version.
+// Version 0 programs are read with the version 1 opcode table.
+func getSpec(version uint64) (*versionedSpec, error) {
+	if err := loadSpecs(); err != nil {
+		return nil, err
+	}
+	if version == 0 {
+		version = 1
+	}
+	vs, ok := specs[version]
+	if !ok {
+		return nil, fmt.Errorf("unsupported version")
+	}
+	return vs, nil
+}
+
+// MaxVersion returns the highest TEAL version this package has a langspec for
+func MaxVersion() (uint64, error) {
+	if err := loadSpecs(); err != nil {
+		return 0, err
+	}
+	return specsMaxVersion, nil
+}
+
+// Versions returns every TEAL version this package has a langspec for, in ascending order
+func Versions() ([]uint64, error) {
+	if err := loadSpecs(); err != nil {
+		return nil, err
+	}
+	versions := make([]uint64, 0, len(specs))
+	for version := range specs {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+// GetSpec returns the langspec for the given TEAL version
+func GetSpec(version uint64) (LangSpec, error) {
+	vs, err := getSpec(version)
+	if err != nil {
+		return LangSpec{}, err
+	}
+	return vs.spec, nil
+}
+
+// GetOpcodes returns the operations available at the given TEAL version, ordered by opcode
+func GetOpcodes(version uint64) ([]Operation, error) {
+	vs, err := getSpec(version)
+	if err != nil {
+		return nil, err
+	}
+	ops := make([]Operation, len(vs.spec.Ops))
+	copy(ops, vs.spec.Ops)
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Opcode < ops[j].Opcode })
+	return ops, nil
 }
 
-var spec *langSpec
-var opcodes []operation
+// LookupOpcode returns the operation encoded as opcode at the given TEAL version.
+// ok is false if the version is unsupported or the opcode is not defined at that version.
+func LookupOpcode(version uint64, opcode byte) (op Operation, ok bool) {
+	vs, err := getSpec(version)
+	if err != nil {
+		return
+	}
+	op = vs.opcodes[opcode]
+	ok = op.Name != ""
+	return
+}
+
+// LookupOpcodeByName returns the operation with the given mnemonic at the given TEAL version.
+// ok is false if the version is unsupported or the operation is not defined at that version.
+func LookupOpcodeByName(version uint64, name string) (op Operation, ok bool) {
+	vs, err := getSpec(version)
+	if err != nil {
+		return
+	}
+	op, ok = vs.names[name]
+	return
+}
+
+// ProgramVersion decodes the version varint at the start of a program.
+// It returns the version and the number of bytes the varint occupies.
+func ProgramVersion(program []byte) (version uint64, length int, err error) {
+	if len(program) == 0 {
+		err = fmt.Errorf("empty program")
+		return
+	}
+	version, length = binary.Uvarint(program)
+	if length <= 0 {
+		err = fmt.Errorf("version parsing error")
+	}
+	return
+}
 
 // CheckProgram performs basic program validation: instruction count and program cost
 func CheckProgram(program []byte, args [][]byte) error {
@@ -42,24 +177,12 @@ func CheckProgram(program []byte, args [][]byte) error {
 func ReadProgram(program []byte, args [][]byte) (ints []uint64, byteArrays [][]byte, err error) {
 	const intcblockOpcode = 32
 	const bytecblockOpcode = 38
-	if program == nil || len(program) == 0 {
-		err = fmt.Errorf("empty program")
-		return
-	}
-
-	if spec == nil {
-		spec = new(langSpec)
-		if err = json.Unmarshal(langSpecJson, spec); err != nil {
-			return
-		}
-	}
-	version, vlen := binary.Uvarint(program)
-	if vlen <= 0 {
-		err = fmt.Errorf("version parsing error")
+	version, vlen, err := ProgramVersion(program)
+	if err != nil {
 		return
 	}
-	if int(version) > spec.EvalMaxVersion {
-		err = fmt.Errorf("unsupported version")
+	vs, err := getSpec(version)
+	if err != nil {
 		return
 	}
 
@@ -73,15 +196,8 @@ func ReadProgram(program []byte, args [][]byte) (ints []uint64, byteArrays [][]b
 		return
 	}
 
-	if opcodes == nil {
-		opcodes = make([]operation, 256)
-		for _, op := range spec.Ops {
-			opcodes[op.Opcode] = op
-		}
-	}
-
 	for pc := vlen; pc < len(program); {
-		op := opcodes[program[pc]]
+		op := vs.opcodes[program[pc]]
 		if op.Name == "" {
 			err = fmt.Errorf("invalid instruction")
 			return
@@ -56,6 +56,8 @@ func TestCheckProgram(t *testing.T) {
 	require.EqualError(t, err, "program too costly to run")
 
 	// check TEAL v2 opcodes
+	spec, err := GetSpec(2)
+	require.NoError(t, err)
 	require.True(t, spec.EvalMaxVersion >= 2)
 	require.True(t, spec.LogicSigVersion >= 2)
 	// balance
@@ -73,3 +75,86 @@ func TestCheckProgram(t *testing.T) {
 	err = CheckProgram(program, args)
 	require.NoError(t, err)
 }
+
+func TestCheckProgramVersions(t *testing.T) {
+	var args [][]byte
+
+	// balance is not available before TEAL v2
+	program := []byte{0x01, 0x20, 0x01, 0x00, 0x22, 0x60} // int 0; balance
+	err := CheckProgram(program, args)
+	require.EqualError(t, err, "invalid instruction")
+
+	program[0] = 0x02
+	err = CheckProgram(program, args)
+	require.NoError(t, err)
+
+	// keccak256 got more expensive in TEAL v2
+	program = []byte{0x01, 0x26, 0x01, 0x01, 0x01, 0x28}              // byte 0x01
+	program = append(program, []byte(strings.Repeat("\x02", 200))...) // append 200x keccak256
+	err = CheckProgram(program, args)
+	require.NoError(t, err)
+
+	program[0] = 0x02
+	err = CheckProgram(program, args)
+	require.EqualError(t, err, "program too costly to run")
+
+	maxVersion, err := MaxVersion()
+	require.NoError(t, err)
+	program = []byte{byte(maxVersion + 1), 0x20, 0x01, 0x01, 0x22}
+	err = CheckProgram(program, args)
+	require.EqualError(t, err, "unsupported version")
+}
+
+func TestOpcodeLookup(t *testing.T) {
+	versions, err := Versions()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, versions)
+
+	op, ok := LookupOpcodeByName(2, "txn")
+	require.True(t, ok)
+	require.Equal(t, 0x31, op.Opcode)
+	require.Equal(t, 2, op.Size)
+	require.Equal(t, []string{"Loading Values"}, op.Group)
+	require.Contains(t, op.ArgEnum, "ApplicationID")
+	require.Equal(t, len(op.ArgEnum), len(op.ArgEnumTypes))
+	require.NotEmpty(t, op.Doc)
+
+	op, ok = LookupOpcodeByName(1, "txn")
+	require.True(t, ok)
+	require.NotContains(t, op.ArgEnum, "ApplicationID")
+	require.Equal(t, "TxID", op.ArgEnum[len(op.ArgEnum)-1])
+
+	op, ok = LookupOpcode(1, 0x02)
+	require.True(t, ok)
+	require.Equal(t, "keccak256", op.Name)
+	require.Equal(t, "B", op.Args)
+	require.Equal(t, "B", op.Returns)
+
+	_, ok = LookupOpcode(1, 0x60)
+	require.False(t, ok)
+	_, ok = LookupOpcodeByName(1, "app_global_get")
+	require.False(t, ok)
+	_, ok = LookupOpcodeByName(99, "txn")
+	require.False(t, ok)
+
+	ops, err := GetOpcodes(1)
+	require.NoError(t, err)
+	for i := 1; i < len(ops); i++ {
+		require.True(t, ops[i-1].Opcode < ops[i].Opcode)
+	}
+	_, err = GetOpcodes(99)
+	require.EqualError(t, err, "unsupported version")
+}
+
+func TestProgramVersion(t *testing.T) {
+	version, length, err := ProgramVersion([]byte{0x02, 0x20})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+	require.Equal(t, 1, length)
+
+	_, _, err = ProgramVersion(nil)
+	require.EqualError(t, err, "empty program")
+
+	_, _, err = ProgramVersion([]byte{0x80})
+	require.EqualError(t, err, "version parsing error")
+}
@@ -0,0 +1,176 @@
+package logic
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// sourceMapVersion is the revision of the source map format produced and understood by this package
+const sourceMapVersion = 3
+
+const vlqBase64Chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// SourceLocation is a position in TEAL source. Line and Column are zero-based,
+// as they are in the source map format.
+type SourceLocation struct {
+	Line   int
+	Column int
+}
+
+// String formats the location as a one-based line:column pair
+func (l SourceLocation) String() string {
+	return fmt.Sprintf("%d:%d", l.Line+1, l.Column+1)
+}
+
+// SourceMap relates the pcs of an assembled program to the TEAL source it was
+// assembled from. It follows the revision 3 source map format, treating every
+// pc of the program as one line of the generated file, so it can be written
+// out with encoding/json and consumed by editor tooling.
+type SourceMap struct {
+	Version    int      `json:"version"`
+	File       string   `json:"file,omitempty"`
+	SourceRoot string   `json:"sourceRoot,omitempty"`
+	Sources    []string `json:"sources"`
+	Names      []string `json:"names"`
+	Mappings   string   `json:"mappings"`
+
+	pcToLocation map[int]SourceLocation
+}
+
+// makeSourceMap builds the source map of a program of programLength bytes
+// from the source location of each instruction
+func makeSourceMap(pcToLocation map[int]SourceLocation, programLength int) SourceMap {
+	var prev SourceLocation
+	pcs := make([]string, programLength)
+	for pc := range pcs {
+		location, ok := pcToLocation[pc]
+		if !ok {
+			continue
+		}
+		pcs[pc] = encodeVLQ(0, 0, location.Line-prev.Line, location.Column-prev.Column)
+		prev = location
+	}
+	return SourceMap{
+		Version:      sourceMapVersion,
+		Sources:      []string{""},
+		Names:        []string{},
+		Mappings:     strings.Join(pcs, ";"),
+		pcToLocation: pcToLocation,
+	}
+}
+
+// DecodeSourceMap parses a JSON source map that maps program pcs to TEAL source
+func DecodeSourceMap(data []byte) (sourceMap SourceMap, err error) {
+	err = json.Unmarshal(data, &sourceMap)
+	if err != nil {
+		return
+	}
+	if sourceMap.Version != sourceMapVersion {
+		err = fmt.Errorf("unsupported source map version %d", sourceMap.Version)
+		return
+	}
+	sourceMap.pcToLocation, err = decodeMappings(sourceMap.Mappings)
+	return
+}
+
+// GetLocationForPc returns the source location of the instruction at pc.
+// ok is false if the pc was not produced by a source instruction.
+func (sm SourceMap) GetLocationForPc(pc int) (location SourceLocation, ok bool) {
+	location, ok = sm.pcToLocation[pc]
+	return
+}
+
+// GetPcsForLine returns the pcs of the instructions assembled from the given
+// zero-based source line, in ascending order
+func (sm SourceMap) GetPcsForLine(line int) []int {
+	var pcs []int
+	for pc, location := range sm.pcToLocation {
+		if location.Line == line {
+			pcs = append(pcs, pc)
+		}
+	}
+	sort.Ints(pcs)
+	return pcs
+}
+
+// decodeMappings reads a mappings string in which every generated line is a pc
+func decodeMappings(mappings string) (map[int]SourceLocation, error) {
+	pcToLocation := make(map[int]SourceLocation)
+	var current SourceLocation
+	for pc, group := range strings.Split(mappings, ";") {
+		for i, segment := range strings.Split(group, ",") {
+			if segment == "" {
+				continue
+			}
+			fields, err := decodeVLQ(segment)
+			if err != nil {
+				return nil, fmt.Errorf("mapping for pc %d: %v", pc, err)
+			}
+			if len(fields) != 1 && len(fields) != 4 && len(fields) != 5 {
+				return nil, fmt.Errorf("mapping for pc %d has %d fields", pc, len(fields))
+			}
+			if len(fields) == 1 {
+				continue
+			}
+			current.Line += fields[2]
+			current.Column += fields[3]
+			// every segment moves the running position, but a pc is located by its first segment
+			if i == 0 {
+				pcToLocation[pc] = current
+			}
+		}
+	}
+	return pcToLocation, nil
+}
+
+// encodeVLQ encodes each value as a base64 variable length quantity
+func encodeVLQ(values ...int) string {
+	var sb strings.Builder
+	for _, value := range values {
+		vlq := value << 1
+		if value < 0 {
+			vlq = (-value << 1) | 1
+		}
+		for {
+			digit := vlq & 0x1f
+			vlq >>= 5
+			if vlq > 0 {
+				digit |= 0x20
+			}
+			sb.WriteByte(vlqBase64Chars[digit])
+			if vlq == 0 {
+				break
+			}
+		}
+	}
+	return sb.String()
+}
+
+// decodeVLQ decodes a segment of base64 variable length quantities
+func decodeVLQ(segment string) ([]int, error) {
+	var values []int
+	value, shift := 0, uint(0)
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(vlqBase64Chars, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid character %q", segment[i])
+		}
+		value += (digit & 0x1f) << shift
+		if digit&0x20 != 0 {
+			shift += 5
+			continue
+		}
+		if value&1 != 0 {
+			values = append(values, -(value >> 1))
+		} else {
+			values = append(values, value>>1)
+		}
+		value, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated value")
+	}
+	return values, nil
+}
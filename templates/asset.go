@@ -0,0 +1,86 @@
+package templates
+
+import (
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// makeAssetOptInTransaction builds the zero amount transfer to itself that opts a contract
+// account in to an asset
+func makeAssetOptInTransaction(contract []byte, assetID, maxFee uint64, params types.SuggestedParams) (types.Transaction, error) {
+	contractAddress := crypto.AddressFromProgram(contract)
+	txn, err := future.MakeAssetAcceptanceTxn(contractAddress.String(), nil, params, assetID)
+	if err != nil {
+		return types.Transaction{}, err
+	}
+	if err = checkMaxFee(txn, maxFee); err != nil {
+		return types.Transaction{}, err
+	}
+	return txn, nil
+}
+
+// getFundedAssetOptInTransactions returns the group opting a contract account in to an asset,
+// as accepted by the asset templates: a payment of amount plus the fee of the opt-in from
+// funder into the account, to be signed by the funder, and the signed zero amount transfer to
+// itself. The payment covers the fee so that nobody can drain the account by sending opt-ins.
+func getFundedAssetOptInTransactions(contract []byte, assetID, maxFee uint64, funder string, amount uint64, params types.SuggestedParams) (funding types.Transaction, signedOptIn []byte, err error) {
+	optIn, err := makeAssetOptInTransaction(contract, assetID, maxFee, params)
+	if err != nil {
+		return types.Transaction{}, nil, err
+	}
+	if funder == optIn.Sender.String() {
+		return types.Transaction{}, nil, constraintErrorf("the contract account cannot fund its own opt-in")
+	}
+	funding, err = future.MakePaymentTxn(funder, optIn.Sender.String(), amount+uint64(optIn.Fee), nil, "", params)
+	if err != nil {
+		return types.Transaction{}, nil, err
+	}
+	gid, err := crypto.ComputeGroupID([]types.Transaction{funding, optIn})
+	if err != nil {
+		return types.Transaction{}, nil, err
+	}
+	funding.Group, optIn.Group = gid, gid
+	_, signedOptIn, err = signContractTransaction(contract, nil, optIn)
+	if err != nil {
+		return types.Transaction{}, nil, err
+	}
+	return funding, signedOptIn, nil
+}
+
+// getAssetCloseOutTransactions returns the signed group that closes an expired contract
+// account's asset holding and then its Algos to closeTo, as accepted by the asset templates
+func getAssetCloseOutTransactions(contract []byte, assetID uint64, closeTo types.Address, expiryRound, maxFee uint64, params types.SuggestedParams) ([]byte, error) {
+	closeAlgos, err := makeCloseOutTransaction(contract, closeTo, expiryRound, params)
+	if err != nil {
+		return nil, err
+	}
+	contractAddress := crypto.AddressFromProgram(contract)
+	closeAsset, err := future.MakeAssetTransferTxn(contractAddress.String(), closeTo.String(), 0, nil, params, closeTo.String(), assetID)
+	if err != nil {
+		return nil, err
+	}
+	return signContractGroup(contract, []types.Transaction{closeAsset, closeAlgos}, maxFee)
+}
+
+// signContractGroup groups transactions sent from a contract account, checks none pays more
+// than maxFee and signs them all with the contract's logic signature
+func signContractGroup(contract []byte, txns []types.Transaction, maxFee uint64) ([]byte, error) {
+	gid, err := crypto.ComputeGroupID(txns)
+	if err != nil {
+		return nil, err
+	}
+	var signedGroup []byte
+	for _, txn := range txns {
+		if err = checkMaxFee(txn, maxFee); err != nil {
+			return nil, err
+		}
+		txn.Group = gid
+		_, stx, err := signContractTransaction(contract, nil, txn)
+		if err != nil {
+			return nil, err
+		}
+		signedGroup = append(signedGroup, stx...)
+	}
+	return signedGroup, nil
+}
@@ -0,0 +1,304 @@
+package templates
+
+import (
+	"encoding/base64"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// assetPeriodicPaymentSource is the TEAL of the AssetPeriodicPayment template
+const assetPeriodicPaymentSource = `#pragma version 2
+// every transaction pays at most the max fee, keeps the account's key and is not a clawback
+txn Fee
+int TMPL_FEE
+<=
+txn RekeyTo
+global ZeroAddress
+==
+&&
+txn AssetSender
+global ZeroAddress
+==
+&&
+bz reject
+global GroupSize
+int 2
+==
+gtxn 0 TypeEnum
+int pay
+==
+&&
+bnz optin
+global GroupSize
+int 2
+==
+bnz close
+global GroupSize
+int 1
+==
+txn TypeEnum
+int axfer
+==
+&&
+txn XferAsset
+int TMPL_ASSET
+==
+&&
+txn AssetCloseTo
+global ZeroAddress
+==
+&&
+txn AssetReceiver
+addr TMPL_RCV
+==
+&&
+bz reject
+// withdraw: the receiver withdraws amount once per period, within withdrawWindow of its start
+txn FirstValid
+int TMPL_PERIOD
+%
+int 0
+==
+txn LastValid
+int TMPL_DUR
+txn FirstValid
++
+==
+&&
+txn Lease
+byte base64 TMPL_LEASE
+==
+&&
+txn AssetAmount
+int TMPL_AMT
+==
+&&
+return
+close:
+// after expiryRound the asset holding and then the account are closed to the receiver
+gtxn 0 Sender
+gtxn 1 Sender
+==
+gtxn 0 TypeEnum
+int axfer
+==
+&&
+gtxn 0 XferAsset
+int TMPL_ASSET
+==
+&&
+gtxn 0 AssetReceiver
+addr TMPL_RCV
+==
+&&
+gtxn 0 AssetCloseTo
+addr TMPL_RCV
+==
+&&
+gtxn 0 AssetAmount
+int 0
+==
+&&
+gtxn 1 TypeEnum
+int pay
+==
+&&
+gtxn 1 Receiver
+global ZeroAddress
+==
+&&
+gtxn 1 Amount
+int 0
+==
+&&
+gtxn 1 CloseRemainderTo
+addr TMPL_RCV
+==
+&&
+txn FirstValid
+int TMPL_TIMEOUT
+>
+&&
+return
+optin:
+// the account opts in to the asset by sending nothing to itself, after a payment into it
+// from another account covering the fee, so that opt-ins cannot drain the account
+txn GroupIndex
+int 1
+==
+gtxn 0 Receiver
+txn Sender
+==
+&&
+gtxn 0 Sender
+txn Sender
+!=
+&&
+gtxn 0 Amount
+txn Fee
+>=
+&&
+txn TypeEnum
+int axfer
+==
+&&
+txn XferAsset
+int TMPL_ASSET
+==
+&&
+txn AssetReceiver
+txn Sender
+==
+&&
+txn AssetAmount
+int 0
+==
+&&
+txn AssetCloseTo
+global ZeroAddress
+==
+&&
+return
+reject:
+int 0
+return`
+
+// AssetPeriodicPayment template representation
+type AssetPeriodicPayment struct {
+	ContractTemplate
+}
+
+// MakeAssetPeriodicPayment allows some account to execute periodic withdrawal of an asset.
+// This is a contract account.
+//
+// The account must first be funded and opt in to the asset, with the group from
+// GetAssetPeriodicPaymentOptInTransaction. This then allows receiver to withdraw
+// amount of the asset every period rounds for withdrawWindow after every multiple
+// of period.
+//
+// After expiryRound, the asset holding and the account can be closed to receiver.
+//
+// Parameters:
+//  - receiver: address which is authorized to receive withdrawals
+//  - assetID: ID of the paid asset
+//  - amount: the amount of the asset in a single withdrawal
+//  - withdrawWindow: the duration of a withdrawal period
+//  - period: the time between a pair of withdrawal periods
+//  - expiryRound: the round at which the account expires
+//  - maxFee: maximum fee used by each transaction from the account
+func MakeAssetPeriodicPayment(receiver string, assetID, amount, withdrawWindow, period, expiryRound, maxFee uint64) (AssetPeriodicPayment, error) {
+	leaseBytes := make([]byte, 32)
+	crypto.RandomBytes(leaseBytes)
+	leaseString := base64.StdEncoding.EncodeToString(leaseBytes)
+	return makeAssetPeriodicPaymentWithLease(receiver, leaseString, assetID, amount, withdrawWindow, period, expiryRound, maxFee)
+}
+
+// makeAssetPeriodicPaymentWithLease is as MakeAssetPeriodicPayment, but the caller can specify the lease (using b64 string)
+func makeAssetPeriodicPaymentWithLease(receiver, lease string, assetID, amount, withdrawWindow, period, expiryRound, maxFee uint64) (AssetPeriodicPayment, error) {
+	template, err := assetPeriodicPaymentTemplate()
+	if err != nil {
+		return AssetPeriodicPayment{}, err
+	}
+	receiverAddr, err := types.DecodeAddress(receiver)
+	if err != nil {
+		return AssetPeriodicPayment{}, err
+	}
+	leaseBytes, err := base64.StdEncoding.DecodeString(lease)
+	if err != nil {
+		return AssetPeriodicPayment{}, err
+	}
+
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_FEE":     maxFee,
+		"TMPL_ASSET":   assetID,
+		"TMPL_RCV":     receiverAddr,
+		"TMPL_PERIOD":  period,
+		"TMPL_DUR":     withdrawWindow,
+		"TMPL_LEASE":   leaseBytes,
+		"TMPL_AMT":     amount,
+		"TMPL_TIMEOUT": expiryRound,
+	})
+	if err != nil {
+		return AssetPeriodicPayment{}, err
+	}
+
+	address := crypto.AddressFromProgram(injectedBytes)
+	assetPeriodicPayment := AssetPeriodicPayment{
+		ContractTemplate: ContractTemplate{
+			address: address.String(),
+			program: injectedBytes,
+		},
+	}
+	return assetPeriodicPayment, err
+}
+
+// GetAssetPeriodicPaymentOptInTransaction returns the group opting an AssetPeriodicPayment
+// contract account in to its asset. funding pays amount plus the fee of the opt-in from funder
+// into the account and must be signed by the funder; signedOptIn follows it, already signed by
+// the contract. Append it to the signed funding transaction to pass the group to
+// SendRawTransaction.
+// contract: the bytecode of the contract
+// funder: the address paying for the opt-in, other than the contract account
+// amount: microAlgos paid into the account on top of the fee, such as its minimum balance
+// params: the fee must not exceed the contract's max fee
+func GetAssetPeriodicPaymentOptInTransaction(contract []byte, funder string, amount uint64, params types.SuggestedParams) (funding types.Transaction, signedOptIn []byte, err error) {
+	parameters, err := decodeAssetPeriodicPayment(contract)
+	if err != nil {
+		return types.Transaction{}, nil, TemplateMismatchError{Template: "AssetPeriodicPayment", Err: err}
+	}
+	return getFundedAssetOptInTransactions(contract, parameters.AssetID, parameters.MaxFee, funder, amount, params)
+}
+
+// GetAssetPeriodicPaymentWithdrawalTransaction returns a signed transaction withdrawing the asset from the contract
+// contract: the bytearray defining the contract, received from the payer
+// firstValid: the first round on which the txn will be valid
+// fee: the fee in microalgos per byte of the transfer txn
+// genesisHash: the hash representing the network for the txn
+func GetAssetPeriodicPaymentWithdrawalTransaction(contract []byte, firstValid, fee uint64, genesisHash []byte) ([]byte, error) {
+	parameters, err := decodeAssetPeriodicPayment(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "AssetPeriodicPayment", Err: err}
+	}
+	if err = checkWithdrawalRound(firstValid, parameters.Period); err != nil {
+		return nil, err
+	}
+	params := types.SuggestedParams{
+		Fee:             types.MicroAlgos(fee),
+		GenesisHash:     genesisHash,
+		FirstRoundValid: types.Round(firstValid),
+		LastRoundValid:  types.Round(firstValid + parameters.WithdrawWindow),
+		FlatFee:         false,
+	}
+	address := crypto.AddressFromProgram(contract)
+	txn, err := future.MakeAssetTransferTxn(address.String(), parameters.Receiver.String(), parameters.Amount, nil, params, "", parameters.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	txn.AddLease(parameters.Lease, fee)
+	if err = checkMaxFee(txn, parameters.MaxFee); err != nil {
+		return nil, err
+	}
+	_, stx, err := signContractTransaction(contract, nil, txn)
+	return stx, err
+}
+
+// GetAssetPeriodicPaymentExpiryTransaction returns a signed group transaction closing an expired
+// AssetPeriodicPayment contract account's asset holding and then its Algos to the receiver.
+// The returned byte array is suitable for passing to SendRawTransaction.
+// contract: the bytecode of the contract
+// params: the first valid round must be after the contract's expiry round, and the fee
+// at most the contract's max fee
+func GetAssetPeriodicPaymentExpiryTransaction(contract []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeAssetPeriodicPayment(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "AssetPeriodicPayment", Err: err}
+	}
+	return getAssetCloseOutTransactions(contract, parameters.AssetID, parameters.Receiver, parameters.ExpiryRound, parameters.MaxFee, params)
+}
+
+// assetPeriodicPaymentTemplate returns the AssetPeriodicPayment template
+func assetPeriodicPaymentTemplate() (Template, error) {
+	return MakeTemplateFromSource(assetPeriodicPaymentSource)
+}
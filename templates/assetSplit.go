@@ -0,0 +1,297 @@
+package templates
+
+import (
+	"math"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// assetSplitSource is the TEAL of the AssetSplit template
+const assetSplitSource = `#pragma version 2
+// every transaction pays at most the max fee, keeps the account's key and is not a clawback
+txn Fee
+int TMPL_FEE
+<=
+txn RekeyTo
+global ZeroAddress
+==
+&&
+txn AssetSender
+global ZeroAddress
+==
+&&
+bz reject
+global GroupSize
+int 2
+==
+gtxn 0 TypeEnum
+int pay
+==
+&&
+bnz optin
+global GroupSize
+int 2
+==
+gtxn 0 Sender
+gtxn 1 Sender
+==
+&&
+gtxn 0 TypeEnum
+int axfer
+==
+&&
+gtxn 0 XferAsset
+int TMPL_ASSET
+==
+&&
+bz reject
+gtxn 1 TypeEnum
+int pay
+==
+bnz close
+// split: receiverOne and receiverTwo are paid the asset at exactly ratn/ratd
+gtxn 1 TypeEnum
+int axfer
+==
+gtxn 1 XferAsset
+int TMPL_ASSET
+==
+&&
+gtxn 0 AssetReceiver
+addr TMPL_RCV1
+==
+&&
+gtxn 1 AssetReceiver
+addr TMPL_RCV2
+==
+&&
+txn AssetCloseTo
+global ZeroAddress
+==
+&&
+gtxn 0 AssetAmount
+int TMPL_RATD
+*
+gtxn 1 AssetAmount
+int TMPL_RATN
+*
+==
+&&
+gtxn 0 AssetAmount
+int TMPL_MINPAY
+>=
+&&
+return
+close:
+// after expiryRound the asset holding and then the account are closed to the owner
+gtxn 0 AssetReceiver
+addr TMPL_OWN
+==
+gtxn 0 AssetCloseTo
+addr TMPL_OWN
+==
+&&
+gtxn 0 AssetAmount
+int 0
+==
+&&
+gtxn 1 Receiver
+global ZeroAddress
+==
+&&
+gtxn 1 Amount
+int 0
+==
+&&
+gtxn 1 CloseRemainderTo
+addr TMPL_OWN
+==
+&&
+txn FirstValid
+int TMPL_TIMEOUT
+>
+&&
+return
+optin:
+// the account opts in to the asset by sending nothing to itself, after a payment into it
+// from another account covering the fee, so that opt-ins cannot drain the account
+txn GroupIndex
+int 1
+==
+gtxn 0 Receiver
+txn Sender
+==
+&&
+gtxn 0 Sender
+txn Sender
+!=
+&&
+gtxn 0 Amount
+txn Fee
+>=
+&&
+txn TypeEnum
+int axfer
+==
+&&
+txn XferAsset
+int TMPL_ASSET
+==
+&&
+txn AssetReceiver
+txn Sender
+==
+&&
+txn AssetAmount
+int 0
+==
+&&
+txn AssetCloseTo
+global ZeroAddress
+==
+&&
+return
+reject:
+int 0
+return`
+
+// AssetSplit template representation
+type AssetSplit struct {
+	ContractTemplate
+}
+
+// MakeAssetSplit splits an asset held by some account between two recipients at some ratio.
+// This is a contract account.
+//
+// The account must first be funded and opt in to the asset, with the group from
+// GetAssetSplitOptInTransaction. Withdrawals from this account are then allowed
+// as a group transaction which sends receiverOne and receiverTwo amounts of the
+// asset with exactly the ratio of ratn/ratd. At least minPay must be sent to receiverOne.
+//
+// After expiryRound passes, the asset holding and the account can be closed to owner.
+//
+// Split ratio:
+// firstRecipient_amount * ratd == secondRecipient_amount * ratn
+//
+// Parameters:
+//  - owner: the address to refund the asset and funds to on timeout
+//  - receiverOne: the first recipient in the split account
+//  - receiverTwo: the second recipient in the split account
+//  - assetID: ID of the split asset
+//  - ratn: fraction determines resource split ratio (numerator)
+//  - ratd: fraction determines resource split ratio (denominator)
+//  - expiryRound: the round at which the account expires
+//  - minPay: minimum amount of the asset to be paid out of the account to receiverOne
+//  - maxFee: maximum fee used by each transaction from the account
+func MakeAssetSplit(owner, receiverOne, receiverTwo string, assetID, ratn, ratd, expiryRound, minPay, maxFee uint64) (AssetSplit, error) {
+	template, err := assetSplitTemplate()
+	if err != nil {
+		return AssetSplit{}, err
+	}
+	ownerAddr, err := types.DecodeAddress(owner)
+	if err != nil {
+		return AssetSplit{}, err
+	}
+	receiverOneAddr, err := types.DecodeAddress(receiverOne)
+	if err != nil {
+		return AssetSplit{}, err
+	}
+	receiverTwoAddr, err := types.DecodeAddress(receiverTwo)
+	if err != nil {
+		return AssetSplit{}, err
+	}
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_FEE":     maxFee,
+		"TMPL_ASSET":   assetID,
+		"TMPL_RCV1":    receiverOneAddr,
+		"TMPL_RCV2":    receiverTwoAddr,
+		"TMPL_RATD":    ratd,
+		"TMPL_RATN":    ratn,
+		"TMPL_MINPAY":  minPay,
+		"TMPL_OWN":     ownerAddr,
+		"TMPL_TIMEOUT": expiryRound,
+	})
+	if err != nil {
+		return AssetSplit{}, err
+	}
+
+	address := crypto.AddressFromProgram(injectedBytes)
+	assetSplit := AssetSplit{
+		ContractTemplate: ContractTemplate{
+			address: address.String(),
+			program: injectedBytes,
+		},
+	}
+	return assetSplit, err
+}
+
+// GetAssetSplitOptInTransaction returns the group opting an AssetSplit contract account in to its
+// asset. funding pays amount plus the fee of the opt-in from funder into the account and must
+// be signed by the funder; signedOptIn follows it, already signed by the contract. Append it to
+// the signed funding transaction to pass the group to SendRawTransaction.
+// contract: the bytecode of the contract
+// funder: the address paying for the opt-in, other than the contract account
+// amount: microAlgos paid into the account on top of the fee, such as its minimum balance
+// params: the fee must not exceed the contract's max fee
+func GetAssetSplitOptInTransaction(contract []byte, funder string, amount uint64, params types.SuggestedParams) (funding types.Transaction, signedOptIn []byte, err error) {
+	parameters, err := decodeAssetSplit(contract)
+	if err != nil {
+		return types.Transaction{}, nil, TemplateMismatchError{Template: "AssetSplit", Err: err}
+	}
+	return getFundedAssetOptInTransactions(contract, parameters.AssetID, parameters.MaxFee, funder, amount, params)
+}
+
+// GetAssetSplitTransaction returns a group transaction array which transfers the asset according to the contract's ratio
+// the returned byte array is suitable for passing to SendRawTransaction
+// contract: the bytecode of the contract to be used
+// assetAmount: uint64 total amount of the asset to be transferred (transfer1_amount + transfer2_amount)
+// params: is typically received from algod, it defines common-to-all-txns arguments like fee and validity period
+func GetAssetSplitTransaction(contract []byte, assetAmount uint64, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeAssetSplit(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "AssetSplit", Err: err}
+	}
+	ratn, ratd := parameters.Ratn, parameters.Ratd
+
+	ratio := float64(ratd) / float64(ratn)
+	amountForReceiverOne := uint64(math.Round(float64(assetAmount) / (1 + ratio)))
+	amountForReceiverTwo := assetAmount - amountForReceiverOne
+	if ratd*amountForReceiverOne != ratn*amountForReceiverTwo {
+		return nil, constraintErrorf("could not split assets in a way that satisfied the contract ratio (%d * %d != %d * %d)", ratd, amountForReceiverOne, ratn, amountForReceiverTwo)
+	}
+	if amountForReceiverOne < parameters.MinPay {
+		return nil, constraintErrorf("receiverOne would be paid %d, less than the contract's minimum payment %d", amountForReceiverOne, parameters.MinPay)
+	}
+
+	from := crypto.AddressFromProgram(contract)
+	tx1, err := future.MakeAssetTransferTxn(from.String(), parameters.ReceiverOne.String(), amountForReceiverOne, nil, params, "", parameters.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	tx2, err := future.MakeAssetTransferTxn(from.String(), parameters.ReceiverTwo.String(), amountForReceiverTwo, nil, params, "", parameters.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	return signContractGroup(contract, []types.Transaction{tx1, tx2}, parameters.MaxFee)
+}
+
+// GetAssetSplitRefundTransaction returns a signed group transaction closing an expired AssetSplit
+// contract account's asset holding and then its Algos to the owner.
+// The returned byte array is suitable for passing to SendRawTransaction.
+// contract: the bytecode of the contract
+// params: the first valid round must be after the contract's expiry round, and the fee
+// at most the contract's max fee
+func GetAssetSplitRefundTransaction(contract []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeAssetSplit(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "AssetSplit", Err: err}
+	}
+	return getAssetCloseOutTransactions(contract, parameters.AssetID, parameters.Owner, parameters.ExpiryRound, parameters.MaxFee, params)
+}
+
+// assetSplitTemplate returns the AssetSplit template
+func assetSplitTemplate() (Template, error) {
+	return MakeTemplateFromSource(assetSplitSource)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/future"
-	"github.com/algorand/go-algorand-sdk/logic"
 	"github.com/algorand/go-algorand-sdk/transaction"
 	"github.com/algorand/go-algorand-sdk/types"
 	"golang.org/x/crypto/ed25519"
@@ -37,8 +36,7 @@ func MakeDynamicFee(receiver, closeRemainder string, amount, firstValid, lastVal
 
 // makeDynamicFeeWithLease is as MakeDynamicFee, but the caller can specify the lease (using b64 string)
 func makeDynamicFeeWithLease(receiver, closeRemainder, lease string, amount, firstValid, lastValid uint64) (DynamicFee, error) {
-	const referenceProgram = "ASAFAgEHBgUmAyD+vKC7FEpaTqe0OKRoGsgObKEFvLYH/FZTJclWlfaiEyDmmpYeby1feshmB5JlUr6YI17TM2PKiJGLuck4qRW2+SB/g7Flf/H8U7ktwYFIodZd/C1LH6PWdyhK3dIAEm2QaTIEIhIzABAjEhAzAAcxABIQMwAIMQESEDEWIxIQMRAjEhAxBygSEDEJKRIQMQgkEhAxAiUSEDEEIQQSEDEGKhIQ"
-	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	template, err := dynamicFeeTemplate()
 	if err != nil {
 		return DynamicFee{}, err
 	}
@@ -54,9 +52,19 @@ func makeDynamicFeeWithLease(receiver, closeRemainder, lease string, amount, fir
 		}
 	}
 
-	var referenceOffsets = []uint64{ /*amount*/ 5 /*firstValid*/, 6 /*lastValid*/, 7 /*receiver*/, 11 /*closeRemainder*/, 44 /*lease*/, 76}
-	injectionVector := []interface{}{amount, firstValid, lastValid, receiverAddr, closeRemainderAddr, lease}
-	injectedBytes, err := inject(referenceAsBytes, referenceOffsets, injectionVector)
+	leaseBytes, err := base64.StdEncoding.DecodeString(lease)
+	if err != nil {
+		return DynamicFee{}, err
+	}
+
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_AMT":   amount,
+		"TMPL_FV":    firstValid,
+		"TMPL_LV":    lastValid,
+		"TMPL_TO":    receiverAddr,
+		"TMPL_CLS":   closeRemainderAddr,
+		"TMPL_LEASE": leaseBytes,
+	})
 	if err != nil {
 		return DynamicFee{}, err
 	}
@@ -71,6 +79,23 @@ func makeDynamicFeeWithLease(receiver, closeRemainder, lease string, amount, fir
 	return dynamicFee, err
 }
 
+// dynamicFeeTemplate returns the DynamicFee template
+func dynamicFeeTemplate() (Template, error) {
+	const referenceProgram = "ASAFAgEHBgUmAyD+vKC7FEpaTqe0OKRoGsgObKEFvLYH/FZTJclWlfaiEyDmmpYeby1feshmB5JlUr6YI17TM2PKiJGLuck4qRW2+SB/g7Flf/H8U7ktwYFIodZd/C1LH6PWdyhK3dIAEm2QaTIEIhIzABAjEhAzAAcxABIQMwAIMQESEDEWIxIQMRAjEhAxBygSEDEJKRIQMQgkEhAxAiUSEDEEIQQSEDEGKhIQ"
+	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	if err != nil {
+		return Template{}, err
+	}
+	return MakeTemplate(referenceAsBytes, []Placeholder{
+		{Name: "TMPL_AMT", Type: UintParameter, Offset: 5},
+		{Name: "TMPL_FV", Type: UintParameter, Offset: 6},
+		{Name: "TMPL_LV", Type: UintParameter, Offset: 7},
+		{Name: "TMPL_TO", Type: AddressParameter, Offset: 10},
+		{Name: "TMPL_CLS", Type: AddressParameter, Offset: 43},
+		{Name: "TMPL_LEASE", Type: BytesParameter, Offset: 76},
+	})
+}
+
 // GetDynamicFeeTransactions creates and signs the secondary dynamic fee transaction, updates
 // transaction fields, and signs as the fee payer; it returns both
 // transactions as bytes suitable for sendRaw.
@@ -82,6 +107,9 @@ func makeDynamicFeeWithLease(receiver, closeRemainder, lease string, amount, fir
 // firstValid - first protocol round on which both transactions will be valid
 // lastValid - last protocol round on which both transactions will be valid
 func GetDynamicFeeTransactions(txn types.Transaction, lsig types.LogicSig, privateKey ed25519.PrivateKey, fee uint64) ([]byte, error) {
+	if _, err := (DynamicFeeOffer{Txn: txn, Lsig: lsig}).Verify(); err != nil {
+		return nil, err
+	}
 	txn.Fee = types.MicroAlgos(fee)
 	eSize, err := transaction.EstimateSize(txn)
 	if err != nil {
@@ -93,6 +121,9 @@ func GetDynamicFeeTransactions(txn types.Transaction, lsig types.LogicSig, priva
 		txn.Fee = transaction.MinTxnFee
 	}
 
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("private key is %d bytes long, expected %d", len(privateKey), ed25519.PrivateKeySize)
+	}
 	address := types.Address{}
 	copy(address[:], privateKey[ed25519.PublicKeySize:])
 	genesisHash := make([]byte, 32)
@@ -116,6 +147,9 @@ func GetDynamicFeeTransactions(txn types.Transaction, lsig types.LogicSig, priva
 	txnGroup := []types.Transaction{feePayTxn, txn}
 
 	updatedTxns, err := transaction.AssignGroupID(txnGroup, "")
+	if err != nil {
+		return nil, err
+	}
 
 	_, stx1Bytes, err := crypto.SignTransaction(privateKey, updatedTxns[0])
 	if err != nil {
@@ -136,27 +170,17 @@ func GetDynamicFeeTransactions(txn types.Transaction, lsig types.LogicSig, priva
 // contract - the bytearray representing the contract in question
 // genesisHash - the bytearray representing the network for the txns
 func SignDynamicFee(contract []byte, privateKey ed25519.PrivateKey, genesisHash []byte) (txn types.Transaction, lsig types.LogicSig, err error) {
-	ints, byteArrays, err := logic.ReadProgram(contract, nil)
+	parameters, err := decodeDynamicFee(contract)
 	if err != nil {
+		err = TemplateMismatchError{Template: "DynamicFee", Err: err}
 		return
 	}
-
-	// Convert the byteArrays[0] to receiver
-	var receiver types.Address //byteArrays[0]
-	n := copy(receiver[:], byteArrays[0])
-	if n != ed25519.PublicKeySize {
-		err = fmt.Errorf("address generated from receiver bytes is the wrong size")
-		return
-	}
-	// Convert the byteArrays[1] to closeRemainderTo
-	var closeRemainderTo types.Address
-	n = copy(closeRemainderTo[:], byteArrays[1])
-	if n != ed25519.PublicKeySize {
-		err = fmt.Errorf("address generated from closeRemainderTo bytes is the wrong size")
+	receiver, closeRemainderTo := parameters.Receiver, parameters.CloseRemainder
+	amount, firstValid, lastValid := parameters.Amount, parameters.FirstValid, parameters.LastValid
+	if len(privateKey) != ed25519.PrivateKeySize {
+		err = fmt.Errorf("private key is %d bytes long, expected %d", len(privateKey), ed25519.PrivateKeySize)
 		return
 	}
-	contractLease := byteArrays[2]
-	amount, firstValid, lastValid := ints[2], ints[3], ints[4]
 	address := types.Address{}
 	copy(address[:], privateKey[ed25519.PublicKeySize:])
 
@@ -174,9 +198,7 @@ func SignDynamicFee(contract []byte, privateKey ed25519.PrivateKey, genesisHash
 	if err != nil {
 		return
 	}
-	lease := [32]byte{}
-	copy(lease[:], contractLease) // convert from []byte to [32]byte
-	txn.AddLease(lease, fee)
+	txn.AddLease(parameters.Lease, fee)
 	lsig, err = crypto.MakeLogicSig(contract, nil, privateKey, crypto.MultisigAccount{})
 
 	return
@@ -0,0 +1,89 @@
+package templates
+
+import (
+	"bytes"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/algorand/go-algorand-sdk/types"
+	"golang.org/x/crypto/ed25519"
+)
+
+// DynamicFeeOffer is what the payer of a DynamicFee contract sends the fee payer: the main
+// transaction and the payer's delegated logic signature of the contract. It is serialized
+// with Encode and DecodeDynamicFeeOffer.
+type DynamicFeeOffer struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Txn  types.Transaction `codec:"txn"`
+	Lsig types.LogicSig    `codec:"lsig"`
+}
+
+// MakeDynamicFeeOffer signs a DynamicFee contract with the payer's key and builds the
+// transaction it allows, as SignDynamicFee does.
+// Parameters:
+// contract - the bytearray representing the contract in question
+// privateKey - the private key of the payer
+// genesisHash - the bytearray representing the network for the txns
+func MakeDynamicFeeOffer(contract []byte, privateKey ed25519.PrivateKey, genesisHash []byte) (DynamicFeeOffer, error) {
+	if _, err := decodeDynamicFee(contract); err != nil {
+		return DynamicFeeOffer{}, TemplateMismatchError{Template: "DynamicFee", Err: err}
+	}
+	txn, lsig, err := SignDynamicFee(contract, privateKey, genesisHash)
+	if err != nil {
+		return DynamicFeeOffer{}, err
+	}
+	return DynamicFeeOffer{Txn: txn, Lsig: lsig}, nil
+}
+
+// Encode returns the msgpack encoding of the offer
+func (offer DynamicFeeOffer) Encode() []byte {
+	return msgpack.Encode(offer)
+}
+
+// DecodeDynamicFeeOffer decodes an offer produced by Encode
+func DecodeDynamicFeeOffer(encoded []byte) (offer DynamicFeeOffer, err error) {
+	err = msgpack.Decode(encoded, &offer)
+	return
+}
+
+// Verify checks the offer's transaction is the one its contract allows, and that the
+// contract is signed by the transaction's sender. It returns the contract's parameters.
+func (offer DynamicFeeOffer) Verify() (DynamicFeeParameters, error) {
+	parameters, err := decodeDynamicFee(offer.Lsig.Logic)
+	if err != nil {
+		return DynamicFeeParameters{}, TemplateMismatchError{Template: "DynamicFee", Err: err}
+	}
+	txn := offer.Txn
+	if txn.Type != types.PaymentTx {
+		return DynamicFeeParameters{}, constraintErrorf("offer's transaction type %s is not %s", txn.Type, types.PaymentTx)
+	}
+	if txn.Receiver != parameters.Receiver {
+		return DynamicFeeParameters{}, constraintErrorf("offer's receiver %s does not match the contract's %s", txn.Receiver, parameters.Receiver)
+	}
+	if uint64(txn.Amount) != parameters.Amount {
+		return DynamicFeeParameters{}, constraintErrorf("offer's amount %d does not match the contract's %d", txn.Amount, parameters.Amount)
+	}
+	if txn.CloseRemainderTo != parameters.CloseRemainder {
+		return DynamicFeeParameters{}, constraintErrorf("offer's close remainder address %s does not match the contract's %s", txn.CloseRemainderTo, parameters.CloseRemainder)
+	}
+	if uint64(txn.FirstValid) != parameters.FirstValid || uint64(txn.LastValid) != parameters.LastValid {
+		return DynamicFeeParameters{}, constraintErrorf("offer's validity window [%d, %d] does not match the contract's [%d, %d]", txn.FirstValid, txn.LastValid, parameters.FirstValid, parameters.LastValid)
+	}
+	if !bytes.Equal(txn.Lease[:], parameters.Lease[:]) {
+		return DynamicFeeParameters{}, constraintErrorf("offer's lease does not match the contract's")
+	}
+	if !crypto.VerifyLogicSig(offer.Lsig, txn.Sender) {
+		return DynamicFeeParameters{}, constraintErrorf("contract is not signed by the offer's sender %s", txn.Sender)
+	}
+	return parameters, nil
+}
+
+// Complete pays the offer's fee from the fee payer's account with GetDynamicFeeTransactions,
+// which verifies the offer first. It returns both transactions as bytes suitable for sendRaw.
+// Parameters:
+// privateKey - the private key for the account that pays the fee
+// fee - fee per byte for both transactions
+func (offer DynamicFeeOffer) Complete(privateKey ed25519.PrivateKey, fee uint64) ([]byte, error) {
+	return GetDynamicFeeTransactions(offer.Txn, offer.Lsig, privateKey, fee)
+}
@@ -0,0 +1,63 @@
+package templates
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TemplateMismatchError is returned by a transaction builder when the program it is given
+// is not an instance of the template it builds transactions for
+type TemplateMismatchError struct {
+	Template string
+	Err      error
+}
+
+func (e TemplateMismatchError) Error() string {
+	return fmt.Sprintf("contract is not an instance of the %s template: %v", e.Template, e.Err)
+}
+
+// ParameterMismatchError is returned when a program is an instance of the right template,
+// but was made with other parameters than the ones the builder expects
+type ParameterMismatchError struct {
+	Template  string
+	Parameter string
+	Expected  interface{}
+	Actual    interface{}
+}
+
+func (e ParameterMismatchError) Error() string {
+	return fmt.Sprintf("%s contract has %s %v, expected %v", e.Template, e.Parameter, e.Actual, e.Expected)
+}
+
+// ConstraintError is returned when a transaction would not satisfy one of its contract's
+// constraints, such as a ratio, a minimum amount, a max fee or a validity window, so the
+// network would reject it
+type ConstraintError struct {
+	Reason string
+}
+
+func (e ConstraintError) Error() string {
+	return e.Reason
+}
+
+func constraintErrorf(format string, args ...interface{}) error {
+	return ConstraintError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// checkParameters returns a ParameterMismatchError for the first parameter which differs
+// between expected and actual, parameters of the same template
+func checkParameters(expected, actual TemplateParameters) error {
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	for i := 0; i < expectedValue.NumField(); i++ {
+		expectedField, actualField := expectedValue.Field(i).Interface(), actualValue.Field(i).Interface()
+		if !reflect.DeepEqual(expectedField, actualField) {
+			return ParameterMismatchError{
+				Template:  expected.TemplateName(),
+				Parameter: expectedValue.Type().Field(i).Name,
+				Expected:  expectedField,
+				Actual:    actualField,
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package templates
+
+import (
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+	"golang.org/x/crypto/ed25519"
+)
+
+// escrowSource is the TEAL of the Escrow template
+const escrowSource = `#pragma version 2
+// every path closes the whole account, paying at most the max fee
+txn TypeEnum
+int pay
+==
+txn Fee
+int TMPL_FEE
+<=
+&&
+txn RekeyTo
+global ZeroAddress
+==
+&&
+txn Receiver
+global ZeroAddress
+==
+&&
+txn Amount
+int 0
+==
+&&
+bz reject
+txn CloseRemainderTo
+addr TMPL_SELLER
+==
+bnz release
+txn CloseRemainderTo
+addr TMPL_BUYER
+==
+bz reject
+txn FirstValid
+int TMPL_TIMEOUT
+>
+bnz accept
+// refund: the seller and the arbiter sign the transaction ID
+txn TxID
+arg 0
+addr TMPL_SELLER
+ed25519verify
+txn TxID
+arg 1
+addr TMPL_ARBITER
+ed25519verify
+&&
+return
+release:
+// release: the buyer and the arbiter sign the transaction ID
+txn TxID
+arg 0
+addr TMPL_BUYER
+ed25519verify
+txn TxID
+arg 1
+addr TMPL_ARBITER
+ed25519verify
+&&
+return
+accept:
+int 1
+return
+reject:
+int 0
+return`
+
+// Escrow template representation
+type Escrow struct {
+	ContractTemplate
+}
+
+// MakeEscrow holds funds paid by a buyer until two of the buyer, the seller and an arbiter agree.
+// This is a contract account.
+//
+// The buyer funds the account. If the buyer and the arbiter agree, the account is closed
+// to the seller. If the seller and the arbiter agree, it is closed back to the buyer.
+// Agreeing means signing the closing transaction's ID with SignEscrowTransaction; the
+// signatures are passed to the contract as arguments by GetEscrowSignedTransaction.
+//
+// After expiryRound passes, the account can be closed back to the buyer without signatures.
+//
+// Parameters:
+//  - buyer: the address funding the escrow, and refunded on timeout
+//  - seller: the address paid when the escrow is released
+//  - arbiter: the address agreeing with either party
+//  - expiryRound: the round at which the account expires
+//  - maxFee: maximum fee used by the closing transaction
+func MakeEscrow(buyer, seller, arbiter string, expiryRound, maxFee uint64) (Escrow, error) {
+	template, err := escrowTemplate()
+	if err != nil {
+		return Escrow{}, err
+	}
+	buyerAddr, err := types.DecodeAddress(buyer)
+	if err != nil {
+		return Escrow{}, err
+	}
+	sellerAddr, err := types.DecodeAddress(seller)
+	if err != nil {
+		return Escrow{}, err
+	}
+	arbiterAddr, err := types.DecodeAddress(arbiter)
+	if err != nil {
+		return Escrow{}, err
+	}
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_FEE":     maxFee,
+		"TMPL_SELLER":  sellerAddr,
+		"TMPL_BUYER":   buyerAddr,
+		"TMPL_TIMEOUT": expiryRound,
+		"TMPL_ARBITER": arbiterAddr,
+	})
+	if err != nil {
+		return Escrow{}, err
+	}
+
+	address := crypto.AddressFromProgram(injectedBytes)
+	escrow := Escrow{
+		ContractTemplate: ContractTemplate{
+			address: address.String(),
+			program: injectedBytes,
+		},
+	}
+	return escrow, err
+}
+
+// GetEscrowReleaseTransaction returns the unsigned transaction closing an Escrow contract
+// account to the seller. It must be signed by the buyer and the arbiter.
+// contract: the bytecode of the contract
+// params: the fee must not exceed the contract's max fee
+func GetEscrowReleaseTransaction(contract []byte, params types.SuggestedParams) (types.Transaction, error) {
+	parameters, err := decodeEscrow(contract)
+	if err != nil {
+		return types.Transaction{}, TemplateMismatchError{Template: "Escrow", Err: err}
+	}
+	return makeEscrowCloseTransaction(contract, parameters.Seller, parameters.MaxFee, params)
+}
+
+// GetEscrowRefundTransaction returns the unsigned transaction closing an Escrow contract
+// account back to the buyer. It must be signed by the seller and the arbiter.
+// contract: the bytecode of the contract
+// params: the fee must not exceed the contract's max fee
+func GetEscrowRefundTransaction(contract []byte, params types.SuggestedParams) (types.Transaction, error) {
+	parameters, err := decodeEscrow(contract)
+	if err != nil {
+		return types.Transaction{}, TemplateMismatchError{Template: "Escrow", Err: err}
+	}
+	return makeEscrowCloseTransaction(contract, parameters.Buyer, parameters.MaxFee, params)
+}
+
+// SignEscrowTransaction returns a party's agreement to a release or refund transaction:
+// its signature of the transaction ID, as checked by the contract
+func SignEscrowTransaction(contract []byte, txn types.Transaction, privateKey ed25519.PrivateKey) (types.Signature, error) {
+	return crypto.TealSignFromProgram(privateKey, crypto.TransactionID(txn), contract)
+}
+
+// GetEscrowSignedTransaction checks the agreement of the two parties a release or refund
+// transaction needs and returns it signed, suitable for passing to SendRawTransaction.
+// contract: the bytecode of the contract
+// txn: the transaction from GetEscrowReleaseTransaction or GetEscrowRefundTransaction
+// partySignature: the buyer's signature for a release, the seller's for a refund
+// arbiterSignature: the arbiter's signature
+func GetEscrowSignedTransaction(contract []byte, txn types.Transaction, partySignature, arbiterSignature types.Signature) ([]byte, error) {
+	parameters, err := decodeEscrow(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "Escrow", Err: err}
+	}
+	var party string
+	var partyAddress types.Address
+	switch txn.CloseRemainderTo {
+	case parameters.Seller:
+		party, partyAddress = "buyer", parameters.Buyer
+	case parameters.Buyer:
+		party, partyAddress = "seller", parameters.Seller
+	default:
+		return nil, constraintErrorf("transaction closes to %s, neither the contract's buyer nor its seller", txn.CloseRemainderTo)
+	}
+	contractAddress := crypto.AddressFromProgram(contract)
+	txid := crypto.TransactionID(txn)
+	if !crypto.VerifyTealSign(partyAddress[:], txid, contractAddress, partySignature) {
+		return nil, constraintErrorf("transaction is not signed by the %s", party)
+	}
+	if !crypto.VerifyTealSign(parameters.Arbiter[:], txid, contractAddress, arbiterSignature) {
+		return nil, constraintErrorf("transaction is not signed by the arbiter")
+	}
+	_, stx, err := signContractTransaction(contract, [][]byte{partySignature[:], arbiterSignature[:]}, txn)
+	return stx, err
+}
+
+// GetEscrowTimeoutTransaction returns a signed transaction closing an expired Escrow contract
+// account back to the buyer. The transaction is suitable for passing to SendRawTransaction.
+// contract: the bytecode of the contract
+// params: the first valid round must be after the contract's expiry round, and the fee
+// at most the contract's max fee
+func GetEscrowTimeoutTransaction(contract []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeEscrow(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "Escrow", Err: err}
+	}
+	txn, err := makeCloseOutTransaction(contract, parameters.Buyer, parameters.ExpiryRound, params)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkMaxFee(txn, parameters.MaxFee); err != nil {
+		return nil, err
+	}
+	_, stx, err := signContractTransaction(contract, nil, txn)
+	return stx, err
+}
+
+// makeEscrowCloseTransaction builds the zero amount payment closing an Escrow contract account to closeTo
+func makeEscrowCloseTransaction(contract []byte, closeTo types.Address, maxFee uint64, params types.SuggestedParams) (types.Transaction, error) {
+	contractAddress := crypto.AddressFromProgram(contract)
+	txn, err := future.MakePaymentTxn(contractAddress.String(), types.Address{}.String(), 0, nil, closeTo.String(), params)
+	if err != nil {
+		return types.Transaction{}, err
+	}
+	if err = checkMaxFee(txn, maxFee); err != nil {
+		return types.Transaction{}, err
+	}
+	return txn, nil
+}
+
+// escrowTemplate returns the Escrow template
+func escrowTemplate() (Template, error) {
+	return MakeTemplateFromSource(escrowSource)
+}
@@ -0,0 +1,263 @@
+package templates
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"github.com/algorand/go-algorand-sdk/logic"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// evaluate runs a logic signature program for the transaction at index of group, as a node
+// would, and returns whether it approves. It implements the opcodes and fields the templates use.
+func evaluate(program []byte, group []types.Transaction, index int) (bool, error) {
+	version, pc, err := logic.ProgramVersion(program)
+	if err != nil {
+		return false, err
+	}
+	var stack []interface{}
+	var ints []uint64
+	var byteConstants [][]byte
+	var scratch [256]interface{}
+	pop := func() interface{} {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return top
+	}
+	popUint := func() (uint64, error) {
+		if len(stack) == 0 {
+			return 0, fmt.Errorf("stack underflow")
+		}
+		value, ok := pop().(uint64)
+		if !ok {
+			return 0, fmt.Errorf("expected a uint")
+		}
+		return value, nil
+	}
+
+	for pc < len(program) {
+		op, ok := logic.LookupOpcode(version, program[pc])
+		if !ok {
+			return false, fmt.Errorf("invalid opcode %d at pc %d", program[pc], pc)
+		}
+		next := pc + op.Size
+		switch op.Name {
+		case "intcblock", "bytecblock":
+			count, n := binary.Uvarint(program[pc+1:])
+			next = pc + 1 + n
+			for i := uint64(0); i < count; i++ {
+				value, n := binary.Uvarint(program[next:])
+				next += n
+				if op.Name == "intcblock" {
+					ints = append(ints, value)
+				} else {
+					byteConstants = append(byteConstants, program[next:next+int(value)])
+					next += int(value)
+				}
+			}
+		case "intc":
+			stack = append(stack, ints[program[pc+1]])
+		case "intc_0", "intc_1", "intc_2", "intc_3":
+			stack = append(stack, ints[op.Name[5]-'0'])
+		case "bytec":
+			stack = append(stack, byteConstants[program[pc+1]])
+		case "bytec_0", "bytec_1", "bytec_2", "bytec_3":
+			stack = append(stack, byteConstants[op.Name[6]-'0'])
+		case "txn":
+			value, err := transactionField(group, index, op.ArgEnum[program[pc+1]])
+			if err != nil {
+				return false, err
+			}
+			stack = append(stack, value)
+		case "gtxn":
+			value, err := transactionField(group, int(program[pc+1]), op.ArgEnum[program[pc+2]])
+			if err != nil {
+				return false, err
+			}
+			stack = append(stack, value)
+		case "global":
+			switch field := op.ArgEnum[program[pc+1]]; field {
+			case "ZeroAddress":
+				stack = append(stack, make([]byte, 32))
+			case "GroupSize":
+				stack = append(stack, uint64(len(group)))
+			default:
+				return false, fmt.Errorf("unsupported global %s", field)
+			}
+		case "store":
+			scratch[program[pc+1]] = pop()
+		case "load":
+			stack = append(stack, scratch[program[pc+1]])
+		case "==", "!=":
+			b, a := pop(), pop()
+			equal := false
+			if aBytes, ok := a.([]byte); ok {
+				bBytes, ok := b.([]byte)
+				equal = ok && bytes.Equal(aBytes, bBytes)
+			} else {
+				equal = a == b
+			}
+			stack = append(stack, boolToUint(equal == (op.Name == "==")))
+		case "!":
+			a, err := popUint()
+			if err != nil {
+				return false, err
+			}
+			stack = append(stack, boolToUint(a == 0))
+		case "mulw":
+			b, err := popUint()
+			if err != nil {
+				return false, err
+			}
+			a, err := popUint()
+			if err != nil {
+				return false, err
+			}
+			high, low := bits.Mul64(a, b)
+			stack = append(stack, high, low)
+		case "&&", "||", "<", ">", "<=", ">=", "+", "-", "*", "/", "%":
+			b, err := popUint()
+			if err != nil {
+				return false, err
+			}
+			a, err := popUint()
+			if err != nil {
+				return false, err
+			}
+			result, err := arithmetic(op.Name, a, b)
+			if err != nil {
+				return false, err
+			}
+			stack = append(stack, result)
+		case "bnz", "bz", "b":
+			target := next + int(binary.BigEndian.Uint16(program[pc+1:]))
+			if op.Name == "b" {
+				next = target
+				break
+			}
+			condition, err := popUint()
+			if err != nil {
+				return false, err
+			}
+			if (condition != 0) == (op.Name == "bnz") {
+				next = target
+			}
+		case "return":
+			next = len(program)
+		case "err":
+			return false, fmt.Errorf("err opcode at pc %d", pc)
+		default:
+			return false, fmt.Errorf("unsupported opcode %s", op.Name)
+		}
+		pc = next
+	}
+	if len(stack) != 1 {
+		return false, fmt.Errorf("stack has %d values at the end of the program", len(stack))
+	}
+	result, ok := stack[0].(uint64)
+	return ok && result != 0, nil
+}
+
+func arithmetic(name string, a, b uint64) (uint64, error) {
+	switch name {
+	case "&&":
+		return boolToUint(a != 0 && b != 0), nil
+	case "||":
+		return boolToUint(a != 0 || b != 0), nil
+	case "<":
+		return boolToUint(a < b), nil
+	case ">":
+		return boolToUint(a > b), nil
+	case "<=":
+		return boolToUint(a <= b), nil
+	case ">=":
+		return boolToUint(a >= b), nil
+	case "+":
+		sum, carry := bits.Add64(a, b, 0)
+		if carry != 0 {
+			return 0, fmt.Errorf("+ overflowed")
+		}
+		return sum, nil
+	case "-":
+		if b > a {
+			return 0, fmt.Errorf("- underflowed")
+		}
+		return a - b, nil
+	case "*":
+		high, low := bits.Mul64(a, b)
+		if high != 0 {
+			return 0, fmt.Errorf("* overflowed")
+		}
+		return low, nil
+	case "/", "%":
+		if b == 0 {
+			return 0, fmt.Errorf("%s by zero", name)
+		}
+		if name == "/" {
+			return a / b, nil
+		}
+		return a % b, nil
+	}
+	return 0, fmt.Errorf("unsupported opcode %s", name)
+}
+
+func boolToUint(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// transactionTypeEnums are the values of the TypeEnum field
+var transactionTypeEnums = map[types.TxType]uint64{
+	types.PaymentTx:         1,
+	types.KeyRegistrationTx: 2,
+	types.AssetConfigTx:     3,
+	types.AssetTransferTx:   4,
+	types.AssetFreezeTx:     5,
+	types.ApplicationCallTx: 6,
+}
+
+func transactionField(group []types.Transaction, index int, field string) (interface{}, error) {
+	if index >= len(group) {
+		return nil, fmt.Errorf("transaction %d is not in a group of %d", index, len(group))
+	}
+	txn := group[index]
+	switch field {
+	case "Sender":
+		return txn.Sender[:], nil
+	case "Fee":
+		return uint64(txn.Fee), nil
+	case "FirstValid":
+		return uint64(txn.FirstValid), nil
+	case "LastValid":
+		return uint64(txn.LastValid), nil
+	case "Lease":
+		return txn.Lease[:], nil
+	case "RekeyTo":
+		return txn.RekeyTo[:], nil
+	case "Receiver":
+		return txn.Receiver[:], nil
+	case "Amount":
+		return uint64(txn.Amount), nil
+	case "CloseRemainderTo":
+		return txn.CloseRemainderTo[:], nil
+	case "TypeEnum":
+		return transactionTypeEnums[txn.Type], nil
+	case "XferAsset":
+		return uint64(txn.XferAsset), nil
+	case "AssetAmount":
+		return txn.AssetAmount, nil
+	case "AssetSender":
+		return txn.AssetSender[:], nil
+	case "AssetReceiver":
+		return txn.AssetReceiver[:], nil
+	case "AssetCloseTo":
+		return txn.AssetCloseTo[:], nil
+	case "GroupIndex":
+		return uint64(index), nil
+	}
+	return nil, fmt.Errorf("unsupported transaction field %s", field)
+}
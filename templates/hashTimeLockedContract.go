@@ -1,9 +1,15 @@
 package templates
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+
+	"golang.org/x/crypto/sha3"
+
 	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
 	"github.com/algorand/go-algorand-sdk/types"
 )
 
@@ -30,15 +36,7 @@ type HTLC struct {
 // - expiryRound : uint64 the round on which the assets can be transferred back to owner
 // - maxFee : uint64 the maximum fee that can be paid to the network by the account
 func MakeHTLC(owner, receiver, hashFunction, hashImage string, expiryRound, maxFee uint64) (HTLC, error) {
-	var referenceProgram string
-	if hashFunction == "sha256" {
-		referenceProgram = "ASAECAEACSYDIOaalh5vLV96yGYHkmVSvpgjXtMzY8qIkYu5yTipFbb5IH+DsWV/8fxTuS3BgUih1l38LUsfo9Z3KErd0gASbZBpIP68oLsUSlpOp7Q4pGgayA5soQW8tgf8VlMlyVaV9qITMQEiDjEQIxIQMQcyAxIQMQgkEhAxCSgSLQEpEhAxCSoSMQIlDRAREA=="
-	} else if hashFunction == "keccak256" {
-		referenceProgram = "ASAECAEACSYDIOaalh5vLV96yGYHkmVSvpgjXtMzY8qIkYu5yTipFbb5IH+DsWV/8fxTuS3BgUih1l38LUsfo9Z3KErd0gASbZBpIP68oLsUSlpOp7Q4pGgayA5soQW8tgf8VlMlyVaV9qITMQEiDjEQIxIQMQcyAxIQMQgkEhAxCSgSLQIpEhAxCSoSMQIlDRAREA=="
-	} else {
-		return HTLC{}, fmt.Errorf("invalid hash function supplied")
-	}
-	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	template, err := htlcTemplate(hashFunction)
 	if err != nil {
 		return HTLC{}, err
 	}
@@ -50,14 +48,17 @@ func MakeHTLC(owner, receiver, hashFunction, hashImage string, expiryRound, maxF
 	if err != nil {
 		return HTLC{}, err
 	}
-	//validate hashImage
-	_, err = base64.StdEncoding.DecodeString(hashImage)
+	hashImageBytes, err := base64.StdEncoding.DecodeString(hashImage)
 	if err != nil {
 		return HTLC{}, err
 	}
-	var referenceOffsets = []uint64{ /*fee*/ 3 /*expiryRound*/, 6 /*receiver*/, 10 /*hashImage*/, 42 /*owner*/, 76}
-	injectionVector := []interface{}{maxFee, expiryRound, receiverAddr, hashImage, ownerAddr}
-	injectedBytes, err := inject(referenceAsBytes, referenceOffsets, injectionVector)
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_FEE":     maxFee,
+		"TMPL_TIMEOUT": expiryRound,
+		"TMPL_RCV":     receiverAddr,
+		"TMPL_HASHIMG": hashImageBytes,
+		"TMPL_OWN":     ownerAddr,
+	})
 	if err != nil {
 		return HTLC{}, err
 	}
@@ -72,12 +73,71 @@ func MakeHTLC(owner, receiver, hashFunction, hashImage string, expiryRound, maxF
 	return htlc, err
 }
 
+// htlcTemplate returns the HTLC template for the given hash function
+func htlcTemplate(hashFunction string) (Template, error) {
+	var referenceProgram string
+	if hashFunction == "sha256" {
+		referenceProgram = "ASAECAEACSYDIOaalh5vLV96yGYHkmVSvpgjXtMzY8qIkYu5yTipFbb5IH+DsWV/8fxTuS3BgUih1l38LUsfo9Z3KErd0gASbZBpIP68oLsUSlpOp7Q4pGgayA5soQW8tgf8VlMlyVaV9qITMQEiDjEQIxIQMQcyAxIQMQgkEhAxCSgSLQEpEhAxCSoSMQIlDRAREA=="
+	} else if hashFunction == "keccak256" {
+		referenceProgram = "ASAECAEACSYDIOaalh5vLV96yGYHkmVSvpgjXtMzY8qIkYu5yTipFbb5IH+DsWV/8fxTuS3BgUih1l38LUsfo9Z3KErd0gASbZBpIP68oLsUSlpOp7Q4pGgayA5soQW8tgf8VlMlyVaV9qITMQEiDjEQIxIQMQcyAxIQMQgkEhAxCSgSLQIpEhAxCSoSMQIlDRAREA=="
+	} else {
+		return Template{}, fmt.Errorf("invalid hash function supplied")
+	}
+	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	if err != nil {
+		return Template{}, err
+	}
+	return MakeTemplate(referenceAsBytes, []Placeholder{
+		{Name: "TMPL_FEE", Type: UintParameter, Offset: 3},
+		{Name: "TMPL_TIMEOUT", Type: UintParameter, Offset: 6},
+		{Name: "TMPL_RCV", Type: AddressParameter, Offset: 9},
+		{Name: "TMPL_HASHIMG", Type: BytesParameter, Offset: 42},
+		{Name: "TMPL_OWN", Type: AddressParameter, Offset: 75},
+	})
+}
+
+// GetClaimTransaction returns a signed transaction releasing the contract's funds to its
+// receiver by revealing the preimage of its hash image, along with the transaction's ID.
+// The receiver, hash function and max fee are decoded from the contract, and the preimage is
+// checked against the hash image before anything is signed.
+// preimage: the value whose hash is the contract's hash image
+// params: the fee must not exceed the contract's max fee
+func (contract HTLC) GetClaimTransaction(preimage []byte, params types.SuggestedParams) (txid string, stx []byte, err error) {
+	parameters, err := decodeHTLC(contract.program)
+	if err != nil {
+		err = TemplateMismatchError{Template: "HTLC", Err: err}
+		return
+	}
+	if err = checkPreimage(parameters, preimage); err != nil {
+		return
+	}
+
+	// the funds are released by closing the account to the receiver with an empty payment
+	contractAddress := crypto.AddressFromProgram(contract.program)
+	txn, err := future.MakePaymentTxn(contractAddress.String(), types.Address{}.String(), 0, nil, parameters.Receiver.String(), params)
+	if err != nil {
+		return
+	}
+	if err = checkMaxFee(txn, parameters.MaxFee); err != nil {
+		return
+	}
+	return signContractTransaction(contract.program, [][]byte{preimage}, txn)
+}
+
 // SignTransactionWithHTLCUnlock accepts a transaction, such as a payment, and builds the HTLC-unlocking signature around that transaction
 func SignTransactionWithHTLCUnlock(program []byte, txn types.Transaction, preImageAsBase64 string) (txid string, stx []byte, err error) {
 	preImageAsArgument, err := base64.StdEncoding.DecodeString(preImageAsBase64)
 	if err != nil {
 		return
 	}
+	parameters, err := decodeHTLC(program)
+	if err != nil {
+		err = TemplateMismatchError{Template: "HTLC", Err: err}
+		return
+	}
+	if err = checkPreimage(parameters, preImageAsArgument); err != nil {
+		return
+	}
 	args := make([][]byte, 1)
 	args[0] = preImageAsArgument
 	var blankMultisig crypto.MultisigAccount
@@ -89,3 +149,20 @@ func SignTransactionWithHTLCUnlock(program []byte, txn types.Transaction, preIma
 	txid, stx, err = crypto.SignLogicsigTransaction(lsig, txn)
 	return
 }
+
+// checkPreimage checks the hash of preimage is the hash image of an HTLC contract
+func checkPreimage(parameters HTLCParameters, preimage []byte) error {
+	var hash []byte
+	if parameters.HashFunction == "sha256" {
+		digest := sha256.Sum256(preimage)
+		hash = digest[:]
+	} else {
+		hasher := sha3.NewLegacyKeccak256()
+		hasher.Write(preimage)
+		hash = hasher.Sum(nil)
+	}
+	if !bytes.Equal(hash, parameters.HashImage) {
+		return constraintErrorf("%s of the preimage does not match the contract's hash image", parameters.HashFunction)
+	}
+	return nil
+}
@@ -0,0 +1,390 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// TemplateParameters is implemented by the decoded parameters of every built-in template
+type TemplateParameters interface {
+	// TemplateName returns the name of the template the parameters belong to
+	TemplateName() string
+}
+
+// SplitParameters are the parameters of a Split contract, as passed to MakeSplit
+type SplitParameters struct {
+	Owner       types.Address
+	ReceiverOne types.Address
+	ReceiverTwo types.Address
+	Ratn        uint64
+	Ratd        uint64
+	ExpiryRound uint64
+	MinPay      uint64
+	MaxFee      uint64
+}
+
+// TemplateName returns "Split"
+func (SplitParameters) TemplateName() string { return "Split" }
+
+// HTLCParameters are the parameters of an HTLC contract, as passed to MakeHTLC
+type HTLCParameters struct {
+	Owner        types.Address
+	Receiver     types.Address
+	HashFunction string
+	HashImage    []byte
+	ExpiryRound  uint64
+	MaxFee       uint64
+}
+
+// TemplateName returns "HTLC"
+func (HTLCParameters) TemplateName() string { return "HTLC" }
+
+// LimitOrderParameters are the parameters of a LimitOrder contract, as passed to MakeLimitOrder
+type LimitOrderParameters struct {
+	Owner       types.Address
+	AssetID     uint64
+	Ratn        uint64
+	Ratd        uint64
+	ExpiryRound uint64
+	MinTrade    uint64
+	MaxFee      uint64
+}
+
+// TemplateName returns "LimitOrder"
+func (LimitOrderParameters) TemplateName() string { return "LimitOrder" }
+
+// PeriodicPaymentParameters are the parameters of a PeriodicPayment contract, as passed to MakePeriodicPayment
+type PeriodicPaymentParameters struct {
+	Receiver       types.Address
+	Amount         uint64
+	WithdrawWindow uint64
+	Period         uint64
+	ExpiryRound    uint64
+	MaxFee         uint64
+	Lease          [32]byte
+}
+
+// TemplateName returns "PeriodicPayment"
+func (PeriodicPaymentParameters) TemplateName() string { return "PeriodicPayment" }
+
+// DynamicFeeParameters are the parameters of a DynamicFee contract, as passed to MakeDynamicFee
+type DynamicFeeParameters struct {
+	Receiver       types.Address
+	CloseRemainder types.Address
+	Amount         uint64
+	FirstValid     uint64
+	LastValid      uint64
+	Lease          [32]byte
+}
+
+// TemplateName returns "DynamicFee"
+func (DynamicFeeParameters) TemplateName() string { return "DynamicFee" }
+
+// AssetSplitParameters are the parameters of an AssetSplit contract, as passed to MakeAssetSplit
+type AssetSplitParameters struct {
+	Owner       types.Address
+	ReceiverOne types.Address
+	ReceiverTwo types.Address
+	AssetID     uint64
+	Ratn        uint64
+	Ratd        uint64
+	ExpiryRound uint64
+	MinPay      uint64
+	MaxFee      uint64
+}
+
+// TemplateName returns "AssetSplit"
+func (AssetSplitParameters) TemplateName() string { return "AssetSplit" }
+
+// AssetPeriodicPaymentParameters are the parameters of an AssetPeriodicPayment contract, as passed to MakeAssetPeriodicPayment
+type AssetPeriodicPaymentParameters struct {
+	Receiver       types.Address
+	AssetID        uint64
+	Amount         uint64
+	WithdrawWindow uint64
+	Period         uint64
+	ExpiryRound    uint64
+	MaxFee         uint64
+	Lease          [32]byte
+}
+
+// TemplateName returns "AssetPeriodicPayment"
+func (AssetPeriodicPaymentParameters) TemplateName() string { return "AssetPeriodicPayment" }
+
+// SellLimitOrderParameters are the parameters of a SellLimitOrder contract, as passed to MakeSellLimitOrder
+type SellLimitOrderParameters struct {
+	Owner       types.Address
+	AssetID     uint64
+	Ratn        uint64
+	Ratd        uint64
+	ExpiryRound uint64
+	MinTrade    uint64
+	MaxFee      uint64
+}
+
+// TemplateName returns "SellLimitOrder"
+func (SellLimitOrderParameters) TemplateName() string { return "SellLimitOrder" }
+
+// EscrowParameters are the parameters of an Escrow contract, as passed to MakeEscrow
+type EscrowParameters struct {
+	Buyer       types.Address
+	Seller      types.Address
+	Arbiter     types.Address
+	ExpiryRound uint64
+	MaxFee      uint64
+}
+
+// TemplateName returns "Escrow"
+func (EscrowParameters) TemplateName() string { return "Escrow" }
+
+// Identify matches a contract program against every built-in template and
+// returns the parameters it was made with: a SplitParameters, HTLCParameters,
+// LimitOrderParameters, PeriodicPaymentParameters, DynamicFeeParameters,
+// AssetSplitParameters, AssetPeriodicPaymentParameters, SellLimitOrderParameters
+// or EscrowParameters.
+func Identify(program []byte) (TemplateParameters, error) {
+	if parameters, err := decodeSplit(program); err == nil {
+		return parameters, nil
+	}
+	if parameters, err := decodeHTLC(program); err == nil {
+		return parameters, nil
+	}
+	if parameters, err := decodeLimitOrder(program); err == nil {
+		return parameters, nil
+	}
+	if parameters, err := decodePeriodicPayment(program); err == nil {
+		return parameters, nil
+	}
+	if parameters, err := decodeDynamicFee(program); err == nil {
+		return parameters, nil
+	}
+	if parameters, err := decodeAssetSplit(program); err == nil {
+		return parameters, nil
+	}
+	if parameters, err := decodeAssetPeriodicPayment(program); err == nil {
+		return parameters, nil
+	}
+	if parameters, err := decodeSellLimitOrder(program); err == nil {
+		return parameters, nil
+	}
+	if parameters, err := decodeEscrow(program); err == nil {
+		return parameters, nil
+	}
+	return nil, fmt.Errorf("program does not match any known template")
+}
+
+func decodeSplit(program []byte) (parameters SplitParameters, err error) {
+	template, err := splitTemplate()
+	if err != nil {
+		return
+	}
+	values, err := template.Extract(program)
+	if err != nil {
+		return
+	}
+	parameters = SplitParameters{
+		Owner:       values["TMPL_OWN"].(types.Address),
+		ReceiverOne: values["TMPL_RCV1"].(types.Address),
+		ReceiverTwo: values["TMPL_RCV2"].(types.Address),
+		Ratn:        values["TMPL_RATN"].(uint64),
+		Ratd:        values["TMPL_RATD"].(uint64),
+		ExpiryRound: values["TMPL_TIMEOUT"].(uint64),
+		MinPay:      values["TMPL_MINPAY"].(uint64),
+		MaxFee:      values["TMPL_FEE"].(uint64),
+	}
+	return
+}
+
+func decodeHTLC(program []byte) (parameters HTLCParameters, err error) {
+	for _, hashFunction := range []string{"sha256", "keccak256"} {
+		var template Template
+		template, err = htlcTemplate(hashFunction)
+		if err != nil {
+			return
+		}
+		var values map[string]interface{}
+		values, err = template.Extract(program)
+		if err != nil {
+			continue
+		}
+		parameters = HTLCParameters{
+			Owner:        values["TMPL_OWN"].(types.Address),
+			Receiver:     values["TMPL_RCV"].(types.Address),
+			HashFunction: hashFunction,
+			HashImage:    values["TMPL_HASHIMG"].([]byte),
+			ExpiryRound:  values["TMPL_TIMEOUT"].(uint64),
+			MaxFee:       values["TMPL_FEE"].(uint64),
+		}
+		return
+	}
+	return
+}
+
+func decodeLimitOrder(program []byte) (parameters LimitOrderParameters, err error) {
+	template, err := limitOrderTemplate()
+	if err != nil {
+		return
+	}
+	values, err := template.Extract(program)
+	if err != nil {
+		return
+	}
+	parameters = LimitOrderParameters{
+		Owner:       values["TMPL_OWN"].(types.Address),
+		AssetID:     values["TMPL_ASSET"].(uint64),
+		Ratn:        values["TMPL_SWAPN"].(uint64),
+		Ratd:        values["TMPL_SWAPD"].(uint64),
+		ExpiryRound: values["TMPL_TIMEOUT"].(uint64),
+		MinTrade:    values["TMPL_MINTRD"].(uint64),
+		MaxFee:      values["TMPL_FEE"].(uint64),
+	}
+	return
+}
+
+func decodePeriodicPayment(program []byte) (parameters PeriodicPaymentParameters, err error) {
+	template, err := periodicPaymentTemplate()
+	if err != nil {
+		return
+	}
+	values, err := template.Extract(program)
+	if err != nil {
+		return
+	}
+	lease, err := decodeLease(values["TMPL_LEASE"].([]byte))
+	if err != nil {
+		return
+	}
+	parameters = PeriodicPaymentParameters{
+		Receiver:       values["TMPL_RCV"].(types.Address),
+		Amount:         values["TMPL_AMT"].(uint64),
+		WithdrawWindow: values["TMPL_DUR"].(uint64),
+		Period:         values["TMPL_PERIOD"].(uint64),
+		ExpiryRound:    values["TMPL_TIMEOUT"].(uint64),
+		MaxFee:         values["TMPL_FEE"].(uint64),
+		Lease:          lease,
+	}
+	return
+}
+
+func decodeDynamicFee(program []byte) (parameters DynamicFeeParameters, err error) {
+	template, err := dynamicFeeTemplate()
+	if err != nil {
+		return
+	}
+	values, err := template.Extract(program)
+	if err != nil {
+		return
+	}
+	lease, err := decodeLease(values["TMPL_LEASE"].([]byte))
+	if err != nil {
+		return
+	}
+	parameters = DynamicFeeParameters{
+		Receiver:       values["TMPL_TO"].(types.Address),
+		CloseRemainder: values["TMPL_CLS"].(types.Address),
+		Amount:         values["TMPL_AMT"].(uint64),
+		FirstValid:     values["TMPL_FV"].(uint64),
+		LastValid:      values["TMPL_LV"].(uint64),
+		Lease:          lease,
+	}
+	return
+}
+
+func decodeAssetSplit(program []byte) (parameters AssetSplitParameters, err error) {
+	template, err := assetSplitTemplate()
+	if err != nil {
+		return
+	}
+	values, err := template.Extract(program)
+	if err != nil {
+		return
+	}
+	parameters = AssetSplitParameters{
+		Owner:       values["TMPL_OWN"].(types.Address),
+		ReceiverOne: values["TMPL_RCV1"].(types.Address),
+		ReceiverTwo: values["TMPL_RCV2"].(types.Address),
+		AssetID:     values["TMPL_ASSET"].(uint64),
+		Ratn:        values["TMPL_RATN"].(uint64),
+		Ratd:        values["TMPL_RATD"].(uint64),
+		ExpiryRound: values["TMPL_TIMEOUT"].(uint64),
+		MinPay:      values["TMPL_MINPAY"].(uint64),
+		MaxFee:      values["TMPL_FEE"].(uint64),
+	}
+	return
+}
+
+func decodeAssetPeriodicPayment(program []byte) (parameters AssetPeriodicPaymentParameters, err error) {
+	template, err := assetPeriodicPaymentTemplate()
+	if err != nil {
+		return
+	}
+	values, err := template.Extract(program)
+	if err != nil {
+		return
+	}
+	lease, err := decodeLease(values["TMPL_LEASE"].([]byte))
+	if err != nil {
+		return
+	}
+	parameters = AssetPeriodicPaymentParameters{
+		Receiver:       values["TMPL_RCV"].(types.Address),
+		AssetID:        values["TMPL_ASSET"].(uint64),
+		Amount:         values["TMPL_AMT"].(uint64),
+		WithdrawWindow: values["TMPL_DUR"].(uint64),
+		Period:         values["TMPL_PERIOD"].(uint64),
+		ExpiryRound:    values["TMPL_TIMEOUT"].(uint64),
+		MaxFee:         values["TMPL_FEE"].(uint64),
+		Lease:          lease,
+	}
+	return
+}
+
+func decodeSellLimitOrder(program []byte) (parameters SellLimitOrderParameters, err error) {
+	template, err := sellLimitOrderTemplate()
+	if err != nil {
+		return
+	}
+	values, err := template.Extract(program)
+	if err != nil {
+		return
+	}
+	parameters = SellLimitOrderParameters{
+		Owner:       values["TMPL_OWN"].(types.Address),
+		AssetID:     values["TMPL_ASSET"].(uint64),
+		Ratn:        values["TMPL_SWAPN"].(uint64),
+		Ratd:        values["TMPL_SWAPD"].(uint64),
+		ExpiryRound: values["TMPL_TIMEOUT"].(uint64),
+		MinTrade:    values["TMPL_MINTRD"].(uint64),
+		MaxFee:      values["TMPL_FEE"].(uint64),
+	}
+	return
+}
+
+func decodeEscrow(program []byte) (parameters EscrowParameters, err error) {
+	template, err := escrowTemplate()
+	if err != nil {
+		return
+	}
+	values, err := template.Extract(program)
+	if err != nil {
+		return
+	}
+	parameters = EscrowParameters{
+		Buyer:       values["TMPL_BUYER"].(types.Address),
+		Seller:      values["TMPL_SELLER"].(types.Address),
+		Arbiter:     values["TMPL_ARBITER"].(types.Address),
+		ExpiryRound: values["TMPL_TIMEOUT"].(uint64),
+		MaxFee:      values["TMPL_FEE"].(uint64),
+	}
+	return
+}
+
+func decodeLease(leaseBytes []byte) (lease [32]byte, err error) {
+	if len(leaseBytes) != len(lease) {
+		err = fmt.Errorf("lease is %d bytes long", len(leaseBytes))
+		return
+	}
+	copy(lease[:], leaseBytes)
+	return
+}
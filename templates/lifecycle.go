@@ -0,0 +1,218 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// minBalance is the minimum balance in microAlgos of an account, and the amount it rises by
+// for each asset the account holds
+const minBalance = 100000
+
+// ContractState is the stage of its lifecycle a contract account is in
+type ContractState int
+
+const (
+	// ContractUnfunded accounts are missing their minimum balance or an asset opt-in
+	ContractUnfunded ContractState = iota
+	// ContractActive accounts are funded, opted in to their assets and not yet expired
+	ContractActive
+	// ContractExpired accounts still hold Algos after their expiry round, and can be swept
+	ContractExpired
+	// ContractClosed accounts hold nothing after their expiry round
+	ContractClosed
+)
+
+// String returns the name of the contract state
+func (s ContractState) String() string {
+	switch s {
+	case ContractUnfunded:
+		return "unfunded"
+	case ContractActive:
+		return "active"
+	case ContractExpired:
+		return "expired"
+	case ContractClosed:
+		return "closed"
+	}
+	return fmt.Sprintf("ContractState(%d)", int(s))
+}
+
+// ContractAccount manages the lifecycle of a contract account made from one of the templates:
+// funding it and opting it in to its assets, following its state and balance on a node, and
+// sweeping it once it expires
+type ContractAccount struct {
+	Parameters TemplateParameters
+	Address    types.Address
+	program    []byte
+	client     *algod.Client
+}
+
+// MakeContractAccount identifies the template of contract and returns its lifecycle manager,
+// reading the account from client. DynamicFee programs are delegated signatures rather than
+// contract accounts, and are rejected.
+func MakeContractAccount(contract ContractTemplate, client *algod.Client) (ContractAccount, error) {
+	program := contract.GetProgram()
+	parameters, err := Identify(program)
+	if err != nil {
+		return ContractAccount{}, err
+	}
+	if _, ok := parameters.(DynamicFeeParameters); ok {
+		return ContractAccount{}, fmt.Errorf("DynamicFee programs sign for an account rather than being one")
+	}
+	return ContractAccount{
+		Parameters: parameters,
+		Address:    crypto.AddressFromProgram(program),
+		program:    program,
+		client:     client,
+	}, nil
+}
+
+// AssetIDs returns the assets the contract account must opt in to
+func (a ContractAccount) AssetIDs() []uint64 {
+	switch p := a.Parameters.(type) {
+	case AssetSplitParameters:
+		return []uint64{p.AssetID}
+	case AssetPeriodicPaymentParameters:
+		return []uint64{p.AssetID}
+	case SellLimitOrderParameters:
+		return []uint64{p.AssetID}
+	}
+	return nil
+}
+
+// ExpiryRound returns the round after which the contract account can be swept
+func (a ContractAccount) ExpiryRound() uint64 {
+	switch p := a.Parameters.(type) {
+	case SplitParameters:
+		return p.ExpiryRound
+	case HTLCParameters:
+		return p.ExpiryRound
+	case LimitOrderParameters:
+		return p.ExpiryRound
+	case PeriodicPaymentParameters:
+		return p.ExpiryRound
+	case AssetSplitParameters:
+		return p.ExpiryRound
+	case AssetPeriodicPaymentParameters:
+		return p.ExpiryRound
+	case SellLimitOrderParameters:
+		return p.ExpiryRound
+	case EscrowParameters:
+		return p.ExpiryRound
+	}
+	return 0
+}
+
+// MinimumBalance returns the microAlgos the contract account must hold once opted in to its assets
+func (a ContractAccount) MinimumBalance() uint64 {
+	return minBalance * uint64(1+len(a.AssetIDs()))
+}
+
+// GetFundingTransactions returns the transactions funding the contract account and opting it in
+// to its asset, if it has one. The funder pays the minimum balance, the fee of the opt-in and
+// amount on top of that. funding must be signed by the funder. For the asset templates it is
+// grouped with signedOptIns, already signed by the contract, which only approves an opt-in
+// following a payment that covers its fee. Append them to the signed funding transaction to
+// pass the group to SendRawTransaction.
+// funder: the address paying for the account
+// amount: microAlgos the account holds above its minimum balance
+// params: txn params for the transactions, the fee of the opt-in must not exceed the contract's max fee
+func (a ContractAccount) GetFundingTransactions(funder string, amount uint64, params types.SuggestedParams) (funding types.Transaction, signedOptIns []byte, err error) {
+	assetIDs := a.AssetIDs()
+	if len(assetIDs) == 0 {
+		funding, err = future.MakePaymentTxn(funder, a.Address.String(), a.MinimumBalance()+amount, nil, "", params)
+		return
+	}
+	// each of the asset templates holds a single asset
+	return getFundedAssetOptInTransactions(a.program, assetIDs[0], a.optInMaxFee(), funder, a.MinimumBalance()+amount, params)
+}
+
+// StateAt returns the state of the contract account given its information, as of the
+// round the information was read at. An account which was never funded and has expired
+// is reported as closed.
+func (a ContractAccount) StateAt(account models.Account) ContractState {
+	// a transaction in the round after the one read can be valid after the expiry round
+	expired := account.Round >= a.ExpiryRound()
+	if expired {
+		if account.Amount == 0 {
+			return ContractClosed
+		}
+		return ContractExpired
+	}
+	if account.Amount < a.MinimumBalance() {
+		return ContractUnfunded
+	}
+	for _, assetID := range a.AssetIDs() {
+		if !holdsAsset(account, assetID) {
+			return ContractUnfunded
+		}
+	}
+	return ContractActive
+}
+
+// State reads the contract account from the node and returns its state, with the account
+// information for monitoring its balance
+func (a ContractAccount) State(ctx context.Context) (ContractState, models.Account, error) {
+	account, err := a.client.AccountInformation(a.Address.String()).Do(ctx)
+	if err != nil {
+		return ContractUnfunded, models.Account{}, err
+	}
+	return a.StateAt(account), account, nil
+}
+
+// GetSweepTransactions returns the signed transactions closing an expired contract account,
+// and its asset holdings, to the party the contract refunds.
+// The returned byte array is suitable for passing to SendRawTransaction.
+// params: the first valid round must be after the contract's expiry round, and the fee
+// at most the contract's max fee
+func (a ContractAccount) GetSweepTransactions(params types.SuggestedParams) ([]byte, error) {
+	switch a.Parameters.(type) {
+	case SplitParameters:
+		return GetSplitRefundTransaction(a.program, params)
+	case HTLCParameters:
+		return GetHTLCRefundTransaction(a.program, params)
+	case LimitOrderParameters:
+		return GetLimitOrderRefundTransaction(a.program, params)
+	case PeriodicPaymentParameters:
+		return GetPeriodicPaymentExpiryTransaction(a.program, params)
+	case AssetSplitParameters:
+		return GetAssetSplitRefundTransaction(a.program, params)
+	case AssetPeriodicPaymentParameters:
+		return GetAssetPeriodicPaymentExpiryTransaction(a.program, params)
+	case SellLimitOrderParameters:
+		return GetSellLimitOrderRefundTransaction(a.program, params)
+	case EscrowParameters:
+		return GetEscrowTimeoutTransaction(a.program, params)
+	}
+	return nil, fmt.Errorf("no sweep transactions for %s contracts", a.Parameters.TemplateName())
+}
+
+// optInMaxFee returns the max fee of the contract account's asset opt-ins
+func (a ContractAccount) optInMaxFee() uint64 {
+	switch p := a.Parameters.(type) {
+	case AssetSplitParameters:
+		return p.MaxFee
+	case AssetPeriodicPaymentParameters:
+		return p.MaxFee
+	case SellLimitOrderParameters:
+		return p.MaxFee
+	}
+	return 0
+}
+
+// holdsAsset returns whether account has opted in to assetID
+func holdsAsset(account models.Account, assetID uint64) bool {
+	for _, holding := range account.Assets {
+		if holding.AssetId == assetID {
+			return true
+		}
+	}
+	return false
+}
@@ -2,9 +2,13 @@ package templates
 
 import (
 	"encoding/base64"
+	"fmt"
+	"math/big"
+
 	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/future"
 	"github.com/algorand/go-algorand-sdk/types"
+	"golang.org/x/crypto/ed25519"
 )
 
 // LimitOrder represents a swap between Algos and an Asset at some ratio or better.
@@ -24,13 +28,41 @@ type LimitOrder struct {
 // the second payment sends money (the asset) from Buyer to the Owner
 // these transactions will be rejected if they do not meet the restrictions set by the contract
 func (lo LimitOrder) GetSwapAssetsTransaction(assetAmount, microAlgoAmount uint64, contract, secretKey []byte, params types.SuggestedParams) ([]byte, error) {
-	var buyerAddress types.Address
-	copy(buyerAddress[:], secretKey[32:])
+	parameters, err := decodeLimitOrder(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "LimitOrder", Err: err}
+	}
+	expected, err := decodeLimitOrder(lo.program)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "LimitOrder", Err: err}
+	}
+	if err = checkParameters(expected, parameters); err != nil {
+		return nil, err
+	}
+	if microAlgoAmount <= parameters.MinTrade {
+		return nil, constraintErrorf("%d microAlgos is not above the contract's minimum trade %d", microAlgoAmount, parameters.MinTrade)
+	}
+	received := new(big.Int).Mul(new(big.Int).SetUint64(assetAmount), new(big.Int).SetUint64(parameters.Ratd))
+	asked := new(big.Int).Mul(new(big.Int).SetUint64(microAlgoAmount), new(big.Int).SetUint64(parameters.Ratn))
+	if received.Cmp(asked) < 0 {
+		return nil, constraintErrorf("%d of the asset for %d microAlgos is below the contract's ratio of %d per %d", assetAmount, microAlgoAmount, parameters.Ratn, parameters.Ratd)
+	}
+
+	if len(secretKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("secret key is %d bytes long, expected %d", len(secretKey), ed25519.PrivateKeySize)
+	}
+	buyerAddress, err := crypto.GenerateAddressFromSK(secretKey)
+	if err != nil {
+		return nil, err
+	}
 	contractAddress := crypto.AddressFromProgram(contract)
 	algosForAssets, err := future.MakePaymentTxn(contractAddress.String(), buyerAddress.String(), microAlgoAmount, nil, "", params)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkMaxFee(algosForAssets, parameters.MaxFee); err != nil {
+		return nil, err
+	}
 	assetsForAlgos, err := future.MakeAssetTransferTxn(buyerAddress.String(), lo.owner, assetAmount, nil, params, lo.owner, lo.assetID)
 	if err != nil {
 		return nil, err
@@ -92,19 +124,23 @@ func (lo LimitOrder) GetSwapAssetsTransaction(assetAmount, microAlgoAmount uint6
 //  - minTrade: the minimum amount (of Algos) to be traded away
 //  - maxFee: maximum fee used by the limit order transaction
 func MakeLimitOrder(owner string, assetID, ratn, ratd, expiryRound, minTrade, maxFee uint64) (LimitOrder, error) {
-	const referenceProgram = "ASAKAAEFAgYEBwgJCiYBIP68oLsUSlpOp7Q4pGgayA5soQW8tgf8VlMlyVaV9qITMRYiEjEQIxIQMQEkDhAyBCMSQABVMgQlEjEIIQQNEDEJMgMSEDMBECEFEhAzAREhBhIQMwEUKBIQMwETMgMSEDMBEiEHHTUCNQExCCEIHTUENQM0ATQDDUAAJDQBNAMSNAI0BA8QQAAWADEJKBIxAiEJDRAxBzIDEhAxCCISEBA="
-	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	template, err := limitOrderTemplate()
 	if err != nil {
 		return LimitOrder{}, err
 	}
-
-	var referenceOffsets = []uint64{ /*maxFee*/ 5 /*minTrade*/, 7 /*assetID*/, 9 /*ratd*/, 10 /*ratn*/, 11 /*expiryRound*/, 12 /*ownerAddr*/, 16}
 	ownerAddr, err := types.DecodeAddress(owner)
 	if err != nil {
 		return LimitOrder{}, err
 	}
-	injectionVector := []interface{}{maxFee, minTrade, assetID, ratd, ratn, expiryRound, ownerAddr}
-	injectedBytes, err := inject(referenceAsBytes, referenceOffsets, injectionVector)
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_FEE":     maxFee,
+		"TMPL_MINTRD":  minTrade,
+		"TMPL_ASSET":   assetID,
+		"TMPL_SWAPD":   ratd,
+		"TMPL_SWAPN":   ratn,
+		"TMPL_TIMEOUT": expiryRound,
+		"TMPL_OWN":     ownerAddr,
+	})
 	if err != nil {
 		return LimitOrder{}, err
 	}
@@ -120,3 +156,21 @@ func MakeLimitOrder(owner string, assetID, ratn, ratd, expiryRound, minTrade, ma
 	}
 	return lo, err
 }
+
+// limitOrderTemplate returns the LimitOrder template
+func limitOrderTemplate() (Template, error) {
+	const referenceProgram = "ASAKAAEFAgYEBwgJCiYBIP68oLsUSlpOp7Q4pGgayA5soQW8tgf8VlMlyVaV9qITMRYiEjEQIxIQMQEkDhAyBCMSQABVMgQlEjEIIQQNEDEJMgMSEDMBECEFEhAzAREhBhIQMwEUKBIQMwETMgMSEDMBEiEHHTUCNQExCCEIHTUENQM0ATQDDUAAJDQBNAMSNAI0BA8QQAAWADEJKBIxAiEJDRAxBzIDEhAxCCISEBA="
+	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	if err != nil {
+		return Template{}, err
+	}
+	return MakeTemplate(referenceAsBytes, []Placeholder{
+		{Name: "TMPL_FEE", Type: UintParameter, Offset: 5},
+		{Name: "TMPL_MINTRD", Type: UintParameter, Offset: 7},
+		{Name: "TMPL_ASSET", Type: UintParameter, Offset: 9},
+		{Name: "TMPL_SWAPD", Type: UintParameter, Offset: 10},
+		{Name: "TMPL_SWAPN", Type: UintParameter, Offset: 11},
+		{Name: "TMPL_TIMEOUT", Type: UintParameter, Offset: 12},
+		{Name: "TMPL_OWN", Type: AddressParameter, Offset: 15},
+	})
+}
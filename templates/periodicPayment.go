@@ -2,12 +2,9 @@ package templates
 
 import (
 	"encoding/base64"
-	"fmt"
 	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/future"
-	"github.com/algorand/go-algorand-sdk/logic"
 	"github.com/algorand/go-algorand-sdk/types"
-	"golang.org/x/crypto/ed25519"
 )
 
 // PeriodicPayment template representation
@@ -21,21 +18,14 @@ type PeriodicPayment struct {
 // fee: the fee in microalgos per byte of the payment txn
 // genesisHash: the hash representing the network for the txn
 func GetPeriodicPaymentWithdrawalTransaction(contract []byte, firstValid, fee uint64, genesisHash []byte) ([]byte, error) {
-	address := crypto.AddressFromProgram(contract)
-	ints, byteArrays, err := logic.ReadProgram(contract, nil)
+	parameters, err := decodePeriodicPayment(contract)
 	if err != nil {
-		return nil, err
-	}
-	contractLease := byteArrays[0]
-	// Convert the byteArrays[1] to receiver
-	var receiver types.Address
-	n := copy(receiver[:], byteArrays[1])
-	if n != ed25519.PublicKeySize {
-		return nil, fmt.Errorf("address generated from receiver bytes is the wrong size")
+		return nil, TemplateMismatchError{Template: "PeriodicPayment", Err: err}
 	}
-	period, withdrawWindow, amount := ints[2], ints[4], ints[5]
-	if firstValid%period != 0 {
-		return nil, fmt.Errorf("firstValid round %d was not a multiple of the contract period %d", firstValid, period)
+	address := crypto.AddressFromProgram(contract)
+	receiver, period, withdrawWindow, amount := parameters.Receiver, parameters.Period, parameters.WithdrawWindow, parameters.Amount
+	if err = checkWithdrawalRound(firstValid, period); err != nil {
+		return nil, err
 	}
 	lastValid := firstValid + withdrawWindow
 	params := types.SuggestedParams{
@@ -49,9 +39,10 @@ func GetPeriodicPaymentWithdrawalTransaction(contract []byte, firstValid, fee ui
 	if err != nil {
 		return nil, err
 	}
-	lease := [32]byte{}
-	copy(lease[:], contractLease) // convert from []byte to [32]byte
-	txn.AddLease(lease, fee)
+	txn.AddLease(parameters.Lease, fee)
+	if err = checkMaxFee(txn, parameters.MaxFee); err != nil {
+		return nil, err
+	}
 
 	logicSig, err := crypto.MakeLogicSig(contract, nil, nil, crypto.MultisigAccount{})
 	if err != nil {
@@ -87,8 +78,7 @@ func MakePeriodicPayment(receiver string, amount, withdrawWindow, period, expiry
 
 // makePeriodicPaymentWithLease is as MakePeriodicPayment, but the caller can specify the lease (using b64 string)
 func makePeriodicPaymentWithLease(receiver, lease string, amount, withdrawWindow, period, expiryRound, maxFee uint64) (PeriodicPayment, error) {
-	const referenceProgram = "ASAHAQYFAAQDByYCIAECAwQFBgcIAQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIIJKvkYTkEzwJf2arzJOxERsSogG9nQzKPkpIoc4TzPTFMRAiEjEBIw4QMQIkGCUSEDEEIQQxAggSEDEGKBIQMQkyAxIxBykSEDEIIQUSEDEJKRIxBzIDEhAxAiEGDRAxCCUSEBEQ"
-	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	template, err := periodicPaymentTemplate()
 	if err != nil {
 		return PeriodicPayment{}, err
 	}
@@ -96,10 +86,20 @@ func makePeriodicPaymentWithLease(receiver, lease string, amount, withdrawWindow
 	if err != nil {
 		return PeriodicPayment{}, err
 	}
+	leaseBytes, err := base64.StdEncoding.DecodeString(lease)
+	if err != nil {
+		return PeriodicPayment{}, err
+	}
 
-	var referenceOffsets = []uint64{ /*fee*/ 4 /*period*/, 5 /*withdrawWindow*/, 7 /*amount*/, 8 /*expiryRound*/, 9 /*lease*/, 12 /*receiver*/, 46}
-	injectionVector := []interface{}{maxFee, period, withdrawWindow, amount, expiryRound, lease, receiverAddr}
-	injectedBytes, err := inject(referenceAsBytes, referenceOffsets, injectionVector)
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_FEE":     maxFee,
+		"TMPL_PERIOD":  period,
+		"TMPL_DUR":     withdrawWindow,
+		"TMPL_AMT":     amount,
+		"TMPL_TIMEOUT": expiryRound,
+		"TMPL_LEASE":   leaseBytes,
+		"TMPL_RCV":     receiverAddr,
+	})
 	if err != nil {
 		return PeriodicPayment{}, err
 	}
@@ -113,3 +113,32 @@ func makePeriodicPaymentWithLease(receiver, lease string, amount, withdrawWindow
 	}
 	return periodicPayment, err
 }
+
+// periodicPaymentTemplate returns the PeriodicPayment template
+func periodicPaymentTemplate() (Template, error) {
+	const referenceProgram = "ASAHAQYFAAQDByYCIAECAwQFBgcIAQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIIJKvkYTkEzwJf2arzJOxERsSogG9nQzKPkpIoc4TzPTFMRAiEjEBIw4QMQIkGCUSEDEEIQQxAggSEDEGKBIQMQkyAxIxBykSEDEIIQUSEDEJKRIxBzIDEhAxAiEGDRAxCCUSEBEQ"
+	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	if err != nil {
+		return Template{}, err
+	}
+	return MakeTemplate(referenceAsBytes, []Placeholder{
+		{Name: "TMPL_FEE", Type: UintParameter, Offset: 4},
+		{Name: "TMPL_PERIOD", Type: UintParameter, Offset: 5},
+		{Name: "TMPL_DUR", Type: UintParameter, Offset: 7},
+		{Name: "TMPL_AMT", Type: UintParameter, Offset: 8},
+		{Name: "TMPL_TIMEOUT", Type: UintParameter, Offset: 9},
+		{Name: "TMPL_LEASE", Type: BytesParameter, Offset: 12},
+		{Name: "TMPL_RCV", Type: AddressParameter, Offset: 45},
+	})
+}
+
+// checkWithdrawalRound checks a first valid round is one the periodic payment templates accept
+func checkWithdrawalRound(firstValid, period uint64) error {
+	if period == 0 {
+		return constraintErrorf("contract period is zero")
+	}
+	if firstValid%period != 0 {
+		return constraintErrorf("firstValid round %d was not a multiple of the contract period %d", firstValid, period)
+	}
+	return nil
+}
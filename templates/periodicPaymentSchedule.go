@@ -0,0 +1,87 @@
+package templates
+
+import (
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+)
+
+// WithdrawalWindow is the validity range a PeriodicPayment withdrawal must use
+type WithdrawalWindow struct {
+	FirstValid uint64
+	LastValid  uint64
+}
+
+// PeriodicPaymentSchedule computes when withdrawals from a PeriodicPayment contract are
+// valid, as seen from the last round a node has committed
+type PeriodicPaymentSchedule struct {
+	Parameters PeriodicPaymentParameters
+	// LastRound is the last committed round, so the next round is the first a withdrawal can land in
+	LastRound uint64
+	contract  []byte
+}
+
+// MakePeriodicPaymentSchedule decodes a PeriodicPayment contract and schedules its withdrawals
+// from the last round in status, typically the result of algod's Status
+func MakePeriodicPaymentSchedule(contract []byte, status models.NodeStatus) (PeriodicPaymentSchedule, error) {
+	parameters, err := decodePeriodicPayment(contract)
+	if err != nil {
+		return PeriodicPaymentSchedule{}, TemplateMismatchError{Template: "PeriodicPayment", Err: err}
+	}
+	if parameters.Period == 0 {
+		return PeriodicPaymentSchedule{}, constraintErrorf("contract period is zero")
+	}
+	return PeriodicPaymentSchedule{
+		Parameters: parameters,
+		LastRound:  status.LastRound,
+		contract:   contract,
+	}, nil
+}
+
+// UpcomingWindows returns up to count withdrawal windows, in order, which have not closed
+// by the next round and open no later than the contract's expiry round.
+// The first may already be open.
+func (s PeriodicPaymentSchedule) UpcomingWindows(count int) []WithdrawalWindow {
+	period, window := s.Parameters.Period, s.Parameters.WithdrawWindow
+	nextRound := s.LastRound + 1
+	var firstValid uint64
+	if nextRound > window {
+		// the first multiple of period whose window ends at or after nextRound
+		firstValid = (nextRound - window + period - 1) / period * period
+	}
+	var windows []WithdrawalWindow
+	for ; len(windows) < count && firstValid <= s.Parameters.ExpiryRound; firstValid += period {
+		windows = append(windows, WithdrawalWindow{FirstValid: firstValid, LastValid: firstValid + window})
+	}
+	return windows
+}
+
+// NextWindow returns the first of the UpcomingWindows, and false if there is none because
+// the contract has expired
+func (s PeriodicPaymentSchedule) NextWindow() (WithdrawalWindow, bool) {
+	windows := s.UpcomingWindows(1)
+	if len(windows) == 0 {
+		return WithdrawalWindow{}, false
+	}
+	return windows[0], true
+}
+
+// RemainingLifetime returns the number of rounds after the next round until the contract
+// expires, or zero if it already has
+func (s PeriodicPaymentSchedule) RemainingLifetime() uint64 {
+	nextRound := s.LastRound + 1
+	if nextRound >= s.Parameters.ExpiryRound {
+		return 0
+	}
+	return s.Parameters.ExpiryRound - nextRound
+}
+
+// GetNextWithdrawalTransaction returns a signed withdrawal valid in the next window, carrying
+// the contract's lease. The transaction cannot be submitted before the window opens.
+// fee: the fee in microalgos per byte of the payment txn
+// genesisHash: the hash representing the network for the txn
+func (s PeriodicPaymentSchedule) GetNextWithdrawalTransaction(fee uint64, genesisHash []byte) ([]byte, error) {
+	window, ok := s.NextWindow()
+	if !ok {
+		return nil, constraintErrorf("no withdrawal window opens before the contract's expiry round %d", s.Parameters.ExpiryRound)
+	}
+	return GetPeriodicPaymentWithdrawalTransaction(s.contract, window.FirstValid, fee, genesisHash)
+}
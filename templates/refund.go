@@ -0,0 +1,138 @@
+package templates
+
+import (
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+)
+
+// GetSplitRefundTransaction returns a signed transaction closing an expired Split contract
+// account to its owner. The transaction is suitable for passing to SendRawTransaction.
+// contract: the bytecode of the Split contract
+// params: the first valid round must be after the contract's expiry round, and the fee
+// below the contract's max fee
+func GetSplitRefundTransaction(contract []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeSplit(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "Split", Err: err}
+	}
+	txn, err := makeCloseOutTransaction(contract, parameters.Owner, parameters.ExpiryRound, params)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkSplitMaxFee(txn, parameters.MaxFee); err != nil {
+		return nil, err
+	}
+	_, stx, err := signContractTransaction(contract, nil, txn)
+	return stx, err
+}
+
+// GetHTLCRefundTransaction returns a signed transaction closing an expired HTLC contract
+// account to its owner. The transaction is suitable for passing to SendRawTransaction.
+// contract: the bytecode of the HTLC contract
+// params: the first valid round must be after the contract's expiry round, and the fee
+// at most the contract's max fee
+func GetHTLCRefundTransaction(contract []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeHTLC(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "HTLC", Err: err}
+	}
+	txn, err := makeCloseOutTransaction(contract, parameters.Owner, parameters.ExpiryRound, params)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkMaxFee(txn, parameters.MaxFee); err != nil {
+		return nil, err
+	}
+	// the program hashes its first argument on the refund path too, so one must be present
+	_, stx, err := signContractTransaction(contract, [][]byte{{}}, txn)
+	return stx, err
+}
+
+// GetLimitOrderRefundTransaction returns a signed transaction closing an expired LimitOrder
+// contract account to its owner. The transaction is suitable for passing to SendRawTransaction.
+// contract: the bytecode of the LimitOrder contract
+// params: the first valid round must be after the contract's expiry round, and the fee
+// at most the contract's max fee
+func GetLimitOrderRefundTransaction(contract []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeLimitOrder(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "LimitOrder", Err: err}
+	}
+	txn, err := makeCloseOutTransaction(contract, parameters.Owner, parameters.ExpiryRound, params)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkMaxFee(txn, parameters.MaxFee); err != nil {
+		return nil, err
+	}
+	_, stx, err := signContractTransaction(contract, nil, txn)
+	return stx, err
+}
+
+// GetPeriodicPaymentExpiryTransaction returns a signed transaction closing an expired
+// PeriodicPayment contract account. The template has no owner: once it has expired the
+// remaining funds go to its receiver. The transaction is suitable for passing to SendRawTransaction.
+// contract: the bytecode of the PeriodicPayment contract
+// params: the first valid round must be after the contract's expiry round and a multiple of
+// its period, and the fee at most the contract's max fee. The last valid round is set to the
+// end of the withdrawal window the contract requires.
+func GetPeriodicPaymentExpiryTransaction(contract []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodePeriodicPayment(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "PeriodicPayment", Err: err}
+	}
+	firstValid := uint64(params.FirstRoundValid)
+	if err = checkWithdrawalRound(firstValid, parameters.Period); err != nil {
+		return nil, err
+	}
+	params.LastRoundValid = types.Round(firstValid + parameters.WithdrawWindow)
+	txn, err := makeCloseOutTransaction(contract, parameters.Receiver, parameters.ExpiryRound, params)
+	if err != nil {
+		return nil, err
+	}
+	if params.FlatFee {
+		txn.AddLeaseWithFlatFee(parameters.Lease, uint64(params.Fee))
+	} else {
+		txn.AddLease(parameters.Lease, uint64(params.Fee))
+	}
+	if err = checkMaxFee(txn, parameters.MaxFee); err != nil {
+		return nil, err
+	}
+	_, stx, err := signContractTransaction(contract, nil, txn)
+	return stx, err
+}
+
+// makeCloseOutTransaction builds the zero amount payment that closes a contract account to
+// closeTo, the shape every template accepts once it has expired
+func makeCloseOutTransaction(contract []byte, closeTo types.Address, expiryRound uint64, params types.SuggestedParams) (types.Transaction, error) {
+	if uint64(params.FirstRoundValid) <= expiryRound {
+		return types.Transaction{}, constraintErrorf("first valid round %d is not after the contract's expiry round %d", params.FirstRoundValid, expiryRound)
+	}
+	contractAddress := crypto.AddressFromProgram(contract)
+	return future.MakePaymentTxn(contractAddress.String(), types.Address{}.String(), 0, nil, closeTo.String(), params)
+}
+
+// checkSplitMaxFee is checkMaxFee for Split, the only template requiring the fee to be strictly below its max fee
+func checkSplitMaxFee(txn types.Transaction, maxFee uint64) error {
+	if uint64(txn.Fee) >= maxFee {
+		return constraintErrorf("fee %d is not below the contract's max fee %d", txn.Fee, maxFee)
+	}
+	return nil
+}
+
+func checkMaxFee(txn types.Transaction, maxFee uint64) error {
+	if uint64(txn.Fee) > maxFee {
+		return constraintErrorf("fee %d is above the contract's max fee %d", txn.Fee, maxFee)
+	}
+	return nil
+}
+
+// signContractTransaction signs a transaction sent from a contract account with the contract's logic signature
+func signContractTransaction(contract []byte, args [][]byte, txn types.Transaction) (txid string, stx []byte, err error) {
+	logicSig, err := crypto.MakeLogicSig(contract, args, nil, crypto.MultisigAccount{})
+	if err != nil {
+		return
+	}
+	return crypto.SignLogicsigTransaction(logicSig, txn)
+}
@@ -0,0 +1,376 @@
+package templates
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/types"
+	"golang.org/x/crypto/ed25519"
+)
+
+// sellLimitOrderSource is the TEAL of the SellLimitOrder template
+const sellLimitOrderSource = `#pragma version 2
+// every transaction pays at most the max fee, keeps the account's key and is not a clawback
+txn Fee
+int TMPL_FEE
+<=
+txn RekeyTo
+global ZeroAddress
+==
+&&
+txn AssetSender
+global ZeroAddress
+==
+&&
+bz reject
+global GroupSize
+int 2
+==
+gtxn 0 TypeEnum
+int pay
+==
+&&
+bnz optin
+gtxn 0 TypeEnum
+int axfer
+==
+gtxn 0 XferAsset
+int TMPL_ASSET
+==
+&&
+bz reject
+global GroupSize
+int 2
+==
+gtxn 0 Sender
+gtxn 1 Sender
+==
+&&
+bnz close
+global GroupSize
+int 3
+==
+bz reject
+// fill: the asset goes to the buyer, who pays the owner at least ratn microAlgos per ratd units
+// and pays the fee of the transfer back into the account, so that fills cannot drain it
+txn GroupIndex
+int 0
+==
+gtxn 0 AssetAmount
+int TMPL_MINTRD
+>=
+&&
+gtxn 0 AssetCloseTo
+global ZeroAddress
+==
+&&
+gtxn 1 TypeEnum
+int pay
+==
+&&
+gtxn 1 Receiver
+addr TMPL_OWN
+==
+&&
+gtxn 2 TypeEnum
+int pay
+==
+&&
+gtxn 2 Receiver
+txn Sender
+==
+&&
+gtxn 2 Sender
+txn Sender
+!=
+&&
+gtxn 2 Amount
+gtxn 0 Fee
+>=
+&&
+bz reject
+gtxn 1 Amount
+int TMPL_SWAPD
+mulw
+store 2
+store 1
+gtxn 0 AssetAmount
+int TMPL_SWAPN
+mulw
+store 4
+store 3
+load 1
+load 3
+>
+bnz accept
+load 1
+load 3
+==
+load 2
+load 4
+>=
+&&
+return
+close:
+// after expiryRound the asset holding and then the account are closed to the owner
+gtxn 0 AssetReceiver
+addr TMPL_OWN
+==
+gtxn 0 AssetCloseTo
+addr TMPL_OWN
+==
+&&
+gtxn 0 AssetAmount
+int 0
+==
+&&
+gtxn 1 TypeEnum
+int pay
+==
+&&
+gtxn 1 Receiver
+global ZeroAddress
+==
+&&
+gtxn 1 Amount
+int 0
+==
+&&
+gtxn 1 CloseRemainderTo
+addr TMPL_OWN
+==
+&&
+txn FirstValid
+int TMPL_TIMEOUT
+>
+&&
+return
+optin:
+// the account opts in to the asset by sending nothing to itself, after a payment into it
+// from another account covering the fee, so that opt-ins cannot drain the account
+txn GroupIndex
+int 1
+==
+gtxn 0 Receiver
+txn Sender
+==
+&&
+gtxn 0 Sender
+txn Sender
+!=
+&&
+gtxn 0 Amount
+txn Fee
+>=
+&&
+txn TypeEnum
+int axfer
+==
+&&
+txn XferAsset
+int TMPL_ASSET
+==
+&&
+txn AssetReceiver
+txn Sender
+==
+&&
+txn AssetAmount
+int 0
+==
+&&
+txn AssetCloseTo
+global ZeroAddress
+==
+&&
+return
+accept:
+int 1
+return
+reject:
+int 0
+return`
+
+// SellLimitOrder represents a sale of an Asset for Algos at some ratio or better.
+// It is the mirror of LimitOrder: the contract account holds the asset.
+type SellLimitOrder struct {
+	ContractTemplate
+}
+
+// MakeSellLimitOrder allows a user to sell some number of assets for some number of algos.
+// Fund the contract and opt it in to the asset with GetSellLimitOrderOptInTransaction, then
+// send it the assets you are willing to sell.
+//
+// Works on three cases:
+// * opting the contract account in to the asset
+// * trading the asset for Algos
+// * closing out the asset and Algos back to the originator after a timeout
+//
+// trade case, a 3 transaction group:
+// gtxn[0] (this txn) asset from Me to Other
+// gtxn[1] Algos from Other to the owner
+// gtxn[2] Algos from Other to Me, at least the fee of gtxn[0]
+//
+// We want to get _at least_ some amount of Algos per our asset
+// gtxn[1].Amount / gtxn[0].AssetAmount >= N / D
+// ===
+// gtxn[1].Amount * D >= gtxn[0].AssetAmount * N
+//
+// close-out case, a 2 transaction group after a timeout:
+// gtxn[0] asset holding closed to the owner
+// gtxn[1] Algos closed to the owner
+//
+// Parameters:
+//  - owner: the address paid for the asset, and to refund the asset and funds to on timeout
+//  - assetID: ID of the sold asset
+//  - ratn: exchange rate (N microAlgos per D asset, or better)
+//  - ratd: exchange rate (N microAlgos per D asset, or better)
+//  - expiryRound: the round at which the account expires
+//  - minTrade: the minimum amount (of the asset) to be traded away
+//  - maxFee: maximum fee used by each transaction from the account
+func MakeSellLimitOrder(owner string, assetID, ratn, ratd, expiryRound, minTrade, maxFee uint64) (SellLimitOrder, error) {
+	template, err := sellLimitOrderTemplate()
+	if err != nil {
+		return SellLimitOrder{}, err
+	}
+	ownerAddr, err := types.DecodeAddress(owner)
+	if err != nil {
+		return SellLimitOrder{}, err
+	}
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_FEE":     maxFee,
+		"TMPL_ASSET":   assetID,
+		"TMPL_MINTRD":  minTrade,
+		"TMPL_OWN":     ownerAddr,
+		"TMPL_SWAPD":   ratd,
+		"TMPL_SWAPN":   ratn,
+		"TMPL_TIMEOUT": expiryRound,
+	})
+	if err != nil {
+		return SellLimitOrder{}, err
+	}
+
+	address := crypto.AddressFromProgram(injectedBytes)
+	slo := SellLimitOrder{
+		ContractTemplate: ContractTemplate{
+			address: address.String(),
+			program: injectedBytes,
+		},
+	}
+	return slo, err
+}
+
+// GetSellLimitOrderOptInTransaction returns the group opting a SellLimitOrder contract account
+// in to its asset. funding pays amount plus the fee of the opt-in from funder into the account
+// and must be signed by the funder; signedOptIn follows it, already signed by the contract.
+// Append it to the signed funding transaction to pass the group to SendRawTransaction.
+// contract: the bytecode of the contract
+// funder: the address paying for the opt-in, other than the contract account
+// amount: microAlgos paid into the account on top of the fee, such as its minimum balance
+// params: the fee must not exceed the contract's max fee
+func GetSellLimitOrderOptInTransaction(contract []byte, funder string, amount uint64, params types.SuggestedParams) (funding types.Transaction, signedOptIn []byte, err error) {
+	parameters, err := decodeSellLimitOrder(contract)
+	if err != nil {
+		return types.Transaction{}, nil, TemplateMismatchError{Template: "SellLimitOrder", Err: err}
+	}
+	return getFundedAssetOptInTransactions(contract, parameters.AssetID, parameters.MaxFee, funder, amount, params)
+}
+
+// GetSellLimitOrderFillTransaction returns a group transaction array which buys assets from the contract
+// contract: byteform of the contract
+// assetAmount: amount of the asset to buy
+// microAlgoAmount: number of microAlgos paid to the owner for them
+// secretKey: secret key of the buyer, used to sign the payments
+// params: txn params for the transactions
+// the first transaction sends the asset from the contract to the buyer
+// the second transaction pays Algos from the buyer to the owner
+// the third transaction pays the fee of the first from the buyer back to the contract
+// an error is returned if the amounts do not meet the restrictions set by the contract
+func GetSellLimitOrderFillTransaction(contract []byte, assetAmount, microAlgoAmount uint64, secretKey []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeSellLimitOrder(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "SellLimitOrder", Err: err}
+	}
+	if assetAmount < parameters.MinTrade {
+		return nil, constraintErrorf("asset amount %d is below the contract's minimum trade %d", assetAmount, parameters.MinTrade)
+	}
+	paid := new(big.Int).Mul(new(big.Int).SetUint64(microAlgoAmount), new(big.Int).SetUint64(parameters.Ratd))
+	asked := new(big.Int).Mul(new(big.Int).SetUint64(assetAmount), new(big.Int).SetUint64(parameters.Ratn))
+	if paid.Cmp(asked) < 0 {
+		return nil, constraintErrorf("%d microAlgos for %d of the asset is below the contract's ratio of %d per %d", microAlgoAmount, assetAmount, parameters.Ratn, parameters.Ratd)
+	}
+
+	if len(secretKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("secret key is %d bytes long, expected %d", len(secretKey), ed25519.PrivateKeySize)
+	}
+	buyerAddress, err := crypto.GenerateAddressFromSK(secretKey)
+	if err != nil {
+		return nil, err
+	}
+	contractAddress := crypto.AddressFromProgram(contract)
+	assetsForAlgos, err := future.MakeAssetTransferTxn(contractAddress.String(), buyerAddress.String(), assetAmount, nil, params, "", parameters.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkMaxFee(assetsForAlgos, parameters.MaxFee); err != nil {
+		return nil, err
+	}
+	algosForAssets, err := future.MakePaymentTxn(buyerAddress.String(), parameters.Owner.String(), microAlgoAmount, nil, "", params)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRefund, err := future.MakePaymentTxn(buyerAddress.String(), contractAddress.String(), uint64(assetsForAlgos.Fee), nil, "", params)
+	if err != nil {
+		return nil, err
+	}
+
+	gid, err := crypto.ComputeGroupID([]types.Transaction{assetsForAlgos, algosForAssets, feeRefund})
+	if err != nil {
+		return nil, err
+	}
+	assetsForAlgos.Group = gid
+	algosForAssets.Group = gid
+	feeRefund.Group = gid
+
+	_, assetsForAlgosSigned, err := signContractTransaction(contract, nil, assetsForAlgos)
+	if err != nil {
+		return nil, err
+	}
+	_, algosForAssetsSigned, err := crypto.SignTransaction(secretKey, algosForAssets)
+	if err != nil {
+		return nil, err
+	}
+	_, feeRefundSigned, err := crypto.SignTransaction(secretKey, feeRefund)
+	if err != nil {
+		return nil, err
+	}
+
+	var signedGroup []byte
+	signedGroup = append(signedGroup, assetsForAlgosSigned...)
+	signedGroup = append(signedGroup, algosForAssetsSigned...)
+	signedGroup = append(signedGroup, feeRefundSigned...)
+
+	return signedGroup, nil
+}
+
+// GetSellLimitOrderRefundTransaction returns a signed group transaction closing an expired
+// SellLimitOrder contract account's asset holding and then its Algos to the owner.
+// The returned byte array is suitable for passing to SendRawTransaction.
+// contract: the bytecode of the contract
+// params: the first valid round must be after the contract's expiry round, and the fee
+// at most the contract's max fee
+func GetSellLimitOrderRefundTransaction(contract []byte, params types.SuggestedParams) ([]byte, error) {
+	parameters, err := decodeSellLimitOrder(contract)
+	if err != nil {
+		return nil, TemplateMismatchError{Template: "SellLimitOrder", Err: err}
+	}
+	return getAssetCloseOutTransactions(contract, parameters.AssetID, parameters.Owner, parameters.ExpiryRound, parameters.MaxFee, params)
+}
+
+// sellLimitOrderTemplate returns the SellLimitOrder template
+func sellLimitOrderTemplate() (Template, error) {
+	return MakeTemplateFromSource(sellLimitOrderSource)
+}
@@ -2,14 +2,10 @@ package templates
 
 import (
 	"encoding/base64"
-	"fmt"
 	"math"
 
-	"golang.org/x/crypto/ed25519"
-
 	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/future"
-	"github.com/algorand/go-algorand-sdk/logic"
 	"github.com/algorand/go-algorand-sdk/types"
 )
 
@@ -28,34 +24,22 @@ type Split struct {
 // amount: uint64 total number of algos to be transferred (payment1_amount + payment2_amount)
 // params: is typically received from algod, it defines common-to-all-txns arguments like fee and validity period
 func GetSplitFundsTransaction(contract []byte, amount uint64, params types.SuggestedParams) ([]byte, error) {
-	ints, byteArrays, err := logic.ReadProgram(contract, nil)
+	parameters, err := decodeSplit(contract)
 	if err != nil {
-		return nil, err
-	}
-	ratn := ints[6]
-	ratd := ints[5]
-	// Convert the byteArrays[0] to receiver
-	var receiverOne types.Address //byteArrays[0]
-	n := copy(receiverOne[:], byteArrays[1])
-	if n != ed25519.PublicKeySize {
-		err = fmt.Errorf("address generated from receiver bytes is the wrong size")
-		return nil, err
-	}
-	// Convert the byteArrays[2] to receiverTwo
-	var receiverTwo types.Address
-	n = copy(receiverTwo[:], byteArrays[2])
-	if n != ed25519.PublicKeySize {
-		err = fmt.Errorf("address generated from closeRemainderTo bytes is the wrong size")
-		return nil, err
+		return nil, TemplateMismatchError{Template: "Split", Err: err}
 	}
+	ratn, ratd := parameters.Ratn, parameters.Ratd
+	receiverOne, receiverTwo := parameters.ReceiverOne, parameters.ReceiverTwo
 
 	ratio := float64(ratd) / float64(ratn)
 	amountForReceiverOneFloat := float64(amount) / (1 + ratio)
 	amountForReceiverOne := uint64(math.Round(amountForReceiverOneFloat))
 	amountForReceiverTwo := amount - amountForReceiverOne
 	if ratd*amountForReceiverOne != ratn*amountForReceiverTwo {
-		err = fmt.Errorf("could not split funds in a way that satisfied the contract ratio (%d * %d != %d * %d)", ratd, amountForReceiverOne, ratn, amountForReceiverTwo)
-		return nil, err
+		return nil, constraintErrorf("could not split funds in a way that satisfied the contract ratio (%d * %d != %d * %d)", ratd, amountForReceiverOne, ratn, amountForReceiverTwo)
+	}
+	if amountForReceiverOne < parameters.MinPay {
+		return nil, constraintErrorf("receiverOne would be paid %d, less than the contract's minimum payment %d", amountForReceiverOne, parameters.MinPay)
 	}
 
 	from := crypto.AddressFromProgram(contract)
@@ -67,6 +51,11 @@ func GetSplitFundsTransaction(contract []byte, amount uint64, params types.Sugge
 	if err != nil {
 		return nil, err
 	}
+	for _, txn := range []types.Transaction{tx1, tx2} {
+		if err = checkSplitMaxFee(txn, parameters.MaxFee); err != nil {
+			return nil, err
+		}
+	}
 	gid, err := crypto.ComputeGroupID([]types.Transaction{tx1, tx2})
 	if err != nil {
 		return nil, err
@@ -122,12 +111,10 @@ func GetSplitFundsTransaction(contract []byte, amount uint64, params types.Sugge
 //  - minPay: minimum amount to be paid out of the account to receiverOne
 //  - maxFee: half of the maximum fee used by each split forwarding group transaction
 func MakeSplit(owner, receiverOne, receiverTwo string, ratn, ratd, expiryRound, minPay, maxFee uint64) (Split, error) {
-	const referenceProgram = "ASAIAQUCAAYHCAkmAyCztwQn0+DycN+vsk+vJWcsoz/b7NDS6i33HOkvTpf+YiC3qUpIgHGWE8/1LPh9SGCalSN7IaITeeWSXbfsS5wsXyC4kBQ38Z8zcwWVAym4S8vpFB/c0XC6R4mnPi9EBADsPDEQIhIxASMMEDIEJBJAABkxCSgSMQcyAxIQMQglEhAxAiEEDRAiQAAuMwAAMwEAEjEJMgMSEDMABykSEDMBByoSEDMACCEFCzMBCCEGCxIQMwAIIQcPEBA="
-	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	template, err := splitTemplate()
 	if err != nil {
 		return Split{}, err
 	}
-	var referenceOffsets = []uint64{ /*fee*/ 4 /*timeout*/, 7 /*ratd*/, 8 /*ratn*/, 9 /*minPay*/, 10 /*owner*/, 14 /*receiver1*/, 47 /*receiver2*/, 80}
 	ownerAddr, err := types.DecodeAddress(owner)
 	if err != nil {
 		return Split{}, err
@@ -140,8 +127,16 @@ func MakeSplit(owner, receiverOne, receiverTwo string, ratn, ratd, expiryRound,
 	if err != nil {
 		return Split{}, err
 	}
-	injectionVector := []interface{}{maxFee, expiryRound, ratd, ratn, minPay, ownerAddr, receiverOneAddr, receiverTwoAddr}
-	injectedBytes, err := inject(referenceAsBytes, referenceOffsets, injectionVector)
+	injectedBytes, err := template.Inject(map[string]interface{}{
+		"TMPL_FEE":     maxFee,
+		"TMPL_TIMEOUT": expiryRound,
+		"TMPL_RATD":    ratd,
+		"TMPL_RATN":    ratn,
+		"TMPL_MINPAY":  minPay,
+		"TMPL_OWN":     ownerAddr,
+		"TMPL_RCV1":    receiverOneAddr,
+		"TMPL_RCV2":    receiverTwoAddr,
+	})
 	if err != nil {
 		return Split{}, err
 	}
@@ -159,3 +154,22 @@ func MakeSplit(owner, receiverOne, receiverTwo string, ratn, ratd, expiryRound,
 	}
 	return split, err
 }
+
+// splitTemplate returns the Split template
+func splitTemplate() (Template, error) {
+	const referenceProgram = "ASAIAQUCAAYHCAkmAyCztwQn0+DycN+vsk+vJWcsoz/b7NDS6i33HOkvTpf+YiC3qUpIgHGWE8/1LPh9SGCalSN7IaITeeWSXbfsS5wsXyC4kBQ38Z8zcwWVAym4S8vpFB/c0XC6R4mnPi9EBADsPDEQIhIxASMMEDIEJBJAABkxCSgSMQcyAxIQMQglEhAxAiEEDRAiQAAuMwAAMwEAEjEJMgMSEDMABykSEDMBByoSEDMACCEFCzMBCCEGCxIQMwAIIQcPEBA="
+	referenceAsBytes, err := base64.StdEncoding.DecodeString(referenceProgram)
+	if err != nil {
+		return Template{}, err
+	}
+	return MakeTemplate(referenceAsBytes, []Placeholder{
+		{Name: "TMPL_FEE", Type: UintParameter, Offset: 4},
+		{Name: "TMPL_TIMEOUT", Type: UintParameter, Offset: 7},
+		{Name: "TMPL_RATD", Type: UintParameter, Offset: 8},
+		{Name: "TMPL_RATN", Type: UintParameter, Offset: 9},
+		{Name: "TMPL_MINPAY", Type: UintParameter, Offset: 10},
+		{Name: "TMPL_OWN", Type: AddressParameter, Offset: 13},
+		{Name: "TMPL_RCV1", Type: AddressParameter, Offset: 46},
+		{Name: "TMPL_RCV2", Type: AddressParameter, Offset: 79},
+	})
+}
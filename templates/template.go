@@ -1,9 +1,12 @@
 package templates
 
 import (
-	"encoding/base64"
+	"bytes"
 	"encoding/binary"
 	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand-sdk/logic"
 	"github.com/algorand/go-algorand-sdk/types"
 )
 
@@ -23,60 +26,278 @@ func (contract ContractTemplate) GetProgram() []byte {
 	return contract.program
 }
 
-func replace(buf, newBytes []byte, offset, placeholderLength uint64) []byte {
-	firstChunk := make([]byte, len(buf[:offset]))
-	copy(firstChunk, buf[:offset])
-	firstChunkAmended := append(firstChunk, newBytes...)
-	secondChunk := make([]byte, len(buf[(offset+placeholderLength):]))
-	copy(secondChunk, buf[(offset+placeholderLength):])
-	return append(firstChunkAmended, secondChunk...)
+// ParameterType is the kind of value a template placeholder holds
+type ParameterType int
+
+const (
+	// UintParameter is a uint64 held in the intcblock
+	UintParameter ParameterType = iota
+	// AddressParameter is a types.Address held in the bytecblock
+	AddressParameter
+	// BytesParameter is a []byte held in the bytecblock
+	BytesParameter
+)
+
+// String returns the name of the parameter type
+func (t ParameterType) String() string {
+	switch t {
+	case UintParameter:
+		return "uint"
+	case AddressParameter:
+		return "address"
+	case BytesParameter:
+		return "bytes"
+	}
+	return fmt.Sprintf("ParameterType(%d)", int(t))
+}
+
+// Placeholder is a named value of a template program that is substituted when
+// the template is instantiated
+type Placeholder struct {
+	Name string
+	Type ParameterType
+	// Offset is the position of the value in the reference program: the varint
+	// of a uint, or the length prefix of an address or byte value
+	Offset int
+}
+
+// Template is a TEAL program whose constants are described by placeholders.
+// Values of any size can be substituted for the placeholders and recovered
+// again from an instantiated program.
+type Template struct {
+	program      []byte
+	placeholders []Placeholder
+	// lengths are the encoded lengths of each placeholder's value in program
+	lengths []int
 }
 
-func inject(original []byte, offsets []uint64, values []interface{}) (result []byte, err error) {
-	result = original
-	if len(offsets) != len(values) {
-		err = fmt.Errorf("length of offsets %v does not match length of replacement values %v", len(offsets), len(values))
+// MakeTemplate describes the placeholders of a compiled reference program.
+// Every placeholder must be the offset of an intcblock entry for a uint, or of
+// a bytecblock entry for an address or byte value.
+func MakeTemplate(program []byte, placeholders []Placeholder) (Template, error) {
+	slots, err := constantSlots(program)
+	if err != nil {
+		return Template{}, err
+	}
+
+	sorted := make([]Placeholder, len(placeholders))
+	copy(sorted, placeholders)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Offset < sorted[j].Offset })
+
+	names := make(map[string]bool, len(sorted))
+	lengths := make([]int, len(sorted))
+	for i, placeholder := range sorted {
+		if names[placeholder.Name] {
+			return Template{}, fmt.Errorf("placeholder %s is defined more than once", placeholder.Name)
+		}
+		names[placeholder.Name] = true
+		isBytes, ok := slots[placeholder.Offset]
+		if !ok || isBytes != (placeholder.Type != UintParameter) {
+			return Template{}, fmt.Errorf("placeholder %s at offset %d is not a constant of type %s", placeholder.Name, placeholder.Offset, placeholder.Type)
+		}
+		_, lengths[i], err = decodeParameter(program, placeholder.Offset, placeholder.Type)
+		if err != nil {
+			return Template{}, fmt.Errorf("placeholder %s: %v", placeholder.Name, err)
+		}
+	}
+
+	reference := make([]byte, len(program))
+	copy(reference, program)
+	return Template{program: reference, placeholders: sorted, lengths: lengths}, nil
+}
+
+// MakeTemplateFromSource assembles TEAL source in which constants are replaced
+// by TMPL_ prefixed placeholders. Placeholders used with int are uints, with
+// addr are addresses and with byte are byte values.
+func MakeTemplateFromSource(source string) (Template, error) {
+	program, variables, err := logic.AssembleTemplate(source)
+	if err != nil {
+		return Template{}, err
+	}
+	placeholders := make([]Placeholder, len(variables))
+	for i, variable := range variables {
+		placeholders[i] = Placeholder{Name: variable.Name, Offset: variable.Offset}
+		switch variable.Op {
+		case "int":
+			placeholders[i].Type = UintParameter
+		case "addr":
+			placeholders[i].Type = AddressParameter
+		default:
+			placeholders[i].Type = BytesParameter
+		}
+	}
+	return MakeTemplate(program, placeholders)
+}
+
+// GetReferenceProgram returns the program the placeholders are described against
+func (t Template) GetReferenceProgram() []byte {
+	return t.program
+}
+
+// GetPlaceholders returns the placeholders of the template ordered by offset
+func (t Template) GetPlaceholders() []Placeholder {
+	placeholders := make([]Placeholder, len(t.placeholders))
+	copy(placeholders, t.placeholders)
+	return placeholders
+}
+
+// Inject returns the program with every placeholder replaced by the value of
+// the same name: a uint64 for uints, a types.Address for addresses and a
+// []byte for byte values
+func (t Template) Inject(values map[string]interface{}) ([]byte, error) {
+	for name := range values {
+		if !t.hasPlaceholder(name) {
+			return nil, fmt.Errorf("template has no placeholder %s", name)
+		}
+	}
+
+	var result []byte
+	previous := 0
+	for i, placeholder := range t.placeholders {
+		value, ok := values[placeholder.Name]
+		if !ok {
+			return nil, fmt.Errorf("no value for placeholder %s", placeholder.Name)
+		}
+		encoded, err := encodeParameter(value, placeholder.Type)
+		if err != nil {
+			return nil, fmt.Errorf("placeholder %s: %v", placeholder.Name, err)
+		}
+		result = append(result, t.program[previous:placeholder.Offset]...)
+		result = append(result, encoded...)
+		previous = placeholder.Offset + t.lengths[i]
+	}
+	return append(result, t.program[previous:]...), nil
+}
+
+// Extract recovers the value of every placeholder from a program instantiated
+// from the template. It fails if the program differs from the template anywhere
+// outside the placeholders.
+func (t Template) Extract(program []byte) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(t.placeholders))
+	position, previous := 0, 0
+	for i, placeholder := range t.placeholders {
+		fixed := t.program[previous:placeholder.Offset]
+		if !bytes.HasPrefix(program[position:], fixed) {
+			return nil, fmt.Errorf("program does not match template before placeholder %s", placeholder.Name)
+		}
+		position += len(fixed)
+		value, length, err := decodeParameter(program, position, placeholder.Type)
+		if err != nil {
+			return nil, fmt.Errorf("program does not match template at placeholder %s: %v", placeholder.Name, err)
+		}
+		values[placeholder.Name] = value
+		position += length
+		previous = placeholder.Offset + t.lengths[i]
+	}
+	if !bytes.Equal(program[position:], t.program[previous:]) {
+		return nil, fmt.Errorf("program does not match template after the last placeholder")
+	}
+	return values, nil
+}
+
+func (t Template) hasPlaceholder(name string) bool {
+	for _, placeholder := range t.placeholders {
+		if placeholder.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeParameter encodes a value as it is stored in a constant block
+func encodeParameter(value interface{}, parameterType ParameterType) ([]byte, error) {
+	varint := make([]byte, binary.MaxVarintLen64)
+	switch parameterType {
+	case UintParameter:
+		if uintValue, ok := value.(uint64); ok {
+			return varint[:binary.PutUvarint(varint, uintValue)], nil
+		}
+	case AddressParameter:
+		if address, ok := value.(types.Address); ok {
+			return append(varint[:binary.PutUvarint(varint, uint64(len(address)))], address[:]...), nil
+		}
+	case BytesParameter:
+		if bytesValue, ok := value.([]byte); ok {
+			return append(varint[:binary.PutUvarint(varint, uint64(len(bytesValue)))], bytesValue...), nil
+		}
+	}
+	return nil, fmt.Errorf("value of type %T cannot be used as a %s", value, parameterType)
+}
+
+// decodeParameter decodes the value stored at offset and returns its encoded length
+func decodeParameter(program []byte, offset int, parameterType ParameterType) (value interface{}, length int, err error) {
+	if offset >= len(program) {
+		err = fmt.Errorf("value is past the end of the program")
 		return
 	}
+	decoded, length := binary.Uvarint(program[offset:])
+	if length <= 0 {
+		err = fmt.Errorf("could not decode varint")
+		return
+	}
+	if parameterType == UintParameter {
+		value = decoded
+		return
+	}
+	if decoded > uint64(len(program)-offset-length) {
+		err = fmt.Errorf("value is past the end of the program")
+		return
+	}
+	data := program[offset+length : offset+length+int(decoded)]
+	length += int(decoded)
+	if parameterType == AddressParameter {
+		var address types.Address
+		if len(data) != len(address) {
+			err = fmt.Errorf("address is %d bytes long", len(data))
+			return
+		}
+		copy(address[:], data)
+		value = address
+		return
+	}
+	value = append([]byte{}, data...)
+	return
+}
 
-	for i, value := range values {
-		decodedLength := 0
-		if valueAsUint, ok := value.(uint64); ok {
-			// make the exact minimum buffer needed and no larger
-			// because otherwise there will be extra bytes inserted
-			sizingBuffer := make([]byte, binary.MaxVarintLen64)
-			decodedLength = binary.PutUvarint(sizingBuffer, valueAsUint)
-			fillingBuffer := make([]byte, decodedLength)
-			decodedLength = binary.PutUvarint(fillingBuffer, valueAsUint)
-			result = replace(result, fillingBuffer, offsets[i], uint64(1))
-		} else if address, ok := value.(types.Address); ok {
-			addressLen := uint64(32)
-			addressBytes := make([]byte, addressLen)
-			copy(addressBytes, address[:])
-			result = replace(result, addressBytes, offsets[i], addressLen)
-		} else if b64string, ok := value.(string); ok {
-			decodeBytes, decodeErr := base64.StdEncoding.DecodeString(b64string)
-			if decodeErr != nil {
-				err = decodeErr
-				return
+// constantSlots finds the offset of every constant block entry in a program,
+// mapped to true for bytecblock entries and false for intcblock entries
+func constantSlots(program []byte) (map[int]bool, error) {
+	version, pc, err := logic.ProgramVersion(program)
+	if err != nil {
+		return nil, err
+	}
+	slots := make(map[int]bool)
+	for pc < len(program) {
+		op, ok := logic.LookupOpcode(version, program[pc])
+		if !ok {
+			return nil, fmt.Errorf("invalid instruction at pc %d", pc)
+		}
+		if op.Size != 0 {
+			pc += op.Size
+			continue
+		}
+		isBytes := op.Name == "bytecblock"
+		count, n := binary.Uvarint(program[pc+1:])
+		if n <= 0 {
+			return nil, fmt.Errorf("could not decode %s size at pc %d", op.Name, pc+1)
+		}
+		pc += 1 + n
+		for i := uint64(0); i < count; i++ {
+			if pc >= len(program) {
+				return nil, fmt.Errorf("%s ran past end of program", op.Name)
 			}
-			// do the same thing as in the uint64 case to trim empty bytes:
-			// first fill one buffer to figure out the number of bytes to be written,
-			// then fill a second buffer of exactly the right size
-			sizingBuffer := make([]byte, binary.MaxVarintLen64)
-			numBytesWritten := binary.PutUvarint(sizingBuffer, uint64(len(decodeBytes)))
-			fillingBuffer := make([]byte, numBytesWritten)
-			binary.PutUvarint(fillingBuffer, uint64(len(decodeBytes))) // indicate length of b64 bytes
-			// want to write [length of b64 bytes, b64 bytes]
-			decodeBytes = append(fillingBuffer, decodeBytes...)
-			result = replace(result, decodeBytes, offsets[i], uint64(33))
-		}
-
-		if decodedLength != 0 {
-			for j := range offsets {
-				offsets[j] = offsets[j] + uint64(decodedLength) - 1
+			slots[pc] = isBytes
+			var length int
+			if isBytes {
+				_, length, err = decodeParameter(program, pc, BytesParameter)
+			} else {
+				_, length, err = decodeParameter(program, pc, UintParameter)
 			}
+			if err != nil {
+				return nil, fmt.Errorf("%s entry at pc %d: %v", op.Name, pc, err)
+			}
+			pc += length
 		}
 	}
-	return
+	return slots, nil
 }
@@ -1,14 +1,27 @@
 package templates
 
 import (
+	"bytes"
+	"context"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/algorand/go-algorand-sdk/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	"github.com/algorand/go-algorand-sdk/future"
+	"github.com/algorand/go-algorand-sdk/logic"
 	"github.com/algorand/go-algorand-sdk/types"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
 )
 
 func TestSplit(t *testing.T) {
@@ -67,10 +80,34 @@ func TestHTLC(t *testing.T) {
 	txn, err := future.MakePaymentTxn(goldenAddress, receiver, 0, nil, receiver, params)
 	require.NoError(t, err)
 	preImageAsBase64 := "cHJlaW1hZ2U="
-	_, stx, err := SignTransactionWithHTLCUnlock(c.GetProgram(), txn, preImageAsBase64)
+	unlockTxid, stx, err := SignTransactionWithHTLCUnlock(c.GetProgram(), txn, preImageAsBase64)
 	require.NoError(t, err)
 	goldenStx := "gqRsc2lngqNhcmeRxAhwcmVpbWFnZaFsxJcBIAToBwEAwM8kJgMg5pqWHm8tX3rIZgeSZVK+mCNe0zNjyoiRi7nJOKkVtvkgEHZhE08h/HwCIj1Qq56zYAvD/8NxJCOh5Hux+anb9V8g/ryguxRKWk6ntDikaBrIDmyhBby2B/xWUyXJVpX2ohMxASIOMRAjEhAxBzIDEhAxCCQSEDEJKBItASkSEDEJKhIxAiUNEBEQo3R4boelY2xvc2XEIOaalh5vLV96yGYHkmVSvpgjXtMzY8qIkYu5yTipFbb5o2ZlZc0D6KJmdgGiZ2jEIH+DsWV/8fxTuS3BgUih1l38LUsfo9Z3KErd0gASbZBpomx2ZKNzbmTEIChyiO42rPQZmq42un3UDl1H3kZii2K4CElLvSrIU+oqpHR5cGWjcGF5"
 	require.Equal(t, goldenStx, base64.StdEncoding.EncodeToString(stx))
+
+	// the claim builder makes the same transaction from the contract alone
+	claimTxid, stx, err := c.GetClaimTransaction([]byte("preimage"), params)
+	require.NoError(t, err)
+	require.Equal(t, goldenStx, base64.StdEncoding.EncodeToString(stx))
+	require.Equal(t, unlockTxid, claimTxid)
+	_, _, err = c.GetClaimTransaction([]byte("wrong preimage"), params)
+	require.EqualError(t, err, "sha256 of the preimage does not match the contract's hash image")
+	params.Fee, params.FlatFee = 1001, true
+	_, _, err = c.GetClaimTransaction([]byte("preimage"), params)
+	require.EqualError(t, err, "fee 1001 is above the contract's max fee 1000")
+
+	// keccak256 of "preimage"
+	keccakImage, err := hex.DecodeString("0fb77832bbc1ace4b2352994b34933b9cb89fbff436cb900e8e38ea1ccb0a007")
+	require.NoError(t, err)
+	keccak, err := MakeHTLC(owner, receiver, "keccak256", base64.StdEncoding.EncodeToString(keccakImage), expiryRound, maxFee)
+	require.NoError(t, err)
+	params.Fee = 1000
+	_, stx, err = keccak.GetClaimTransaction([]byte("preimage"), params)
+	require.NoError(t, err)
+	var signed types.SignedTxn
+	require.NoError(t, msgpack.Decode(stx, &signed))
+	require.Equal(t, [][]byte{[]byte("preimage")}, signed.Lsig.Args)
+	require.Equal(t, receiver, signed.Txn.CloseRemainderTo.String())
 }
 
 func TestPeriodicPayment(t *testing.T) {
@@ -99,6 +136,50 @@ func TestPeriodicPayment(t *testing.T) {
 	require.Equal(t, goldenStx, base64.StdEncoding.EncodeToString(stx))
 }
 
+func TestPeriodicPaymentSchedule(t *testing.T) {
+	receiver := "SKXZDBHECM6AS73GVPGJHMIRDMJKEAN5TUGMUPSKJCQ44E6M6TC2H2UJ3I"
+	lease := [32]byte{1, 2, 3}
+	c, err := makePeriodicPaymentWithLease(receiver, base64.StdEncoding.EncodeToString(lease[:]), 500000, 95, 100, 1250, 1000)
+	require.NoError(t, err)
+
+	// round 1195 is the last round of the window opening at 1100
+	schedule, err := MakePeriodicPaymentSchedule(c.GetProgram(), models.NodeStatus{LastRound: 1194})
+	require.NoError(t, err)
+	require.Equal(t, []WithdrawalWindow{{1100, 1195}, {1200, 1295}}, schedule.UpcomingWindows(5))
+	require.Equal(t, uint64(55), schedule.RemainingLifetime())
+	stxBytes, err := schedule.GetNextWithdrawalTransaction(0, []byte{1})
+	require.NoError(t, err)
+	var stx types.SignedTxn
+	require.NoError(t, msgpack.Decode(stxBytes, &stx))
+	require.Equal(t, types.Round(1100), stx.Txn.FirstValid)
+	require.Equal(t, types.Round(1195), stx.Txn.LastValid)
+	require.Equal(t, lease, stx.Txn.Lease)
+
+	schedule, err = MakePeriodicPaymentSchedule(c.GetProgram(), models.NodeStatus{LastRound: 1195})
+	require.NoError(t, err)
+	window, ok := schedule.NextWindow()
+	require.True(t, ok)
+	require.Equal(t, WithdrawalWindow{1200, 1295}, window)
+	require.Len(t, schedule.UpcomingWindows(0), 0)
+
+	schedule, err = MakePeriodicPaymentSchedule(c.GetProgram(), models.NodeStatus{LastRound: 10})
+	require.NoError(t, err)
+	require.Equal(t, []WithdrawalWindow{{0, 95}, {100, 195}}, schedule.UpcomingWindows(2))
+
+	schedule, err = MakePeriodicPaymentSchedule(c.GetProgram(), models.NodeStatus{LastRound: 1300})
+	require.NoError(t, err)
+	_, ok = schedule.NextWindow()
+	require.False(t, ok)
+	require.Equal(t, uint64(0), schedule.RemainingLifetime())
+	_, err = schedule.GetNextWithdrawalTransaction(0, []byte{1})
+	require.EqualError(t, err, "no withdrawal window opens before the contract's expiry round 1250")
+
+	split, err := MakeSplit(receiver, receiver, receiver, 30, 100, 123456, 10000, 5000000)
+	require.NoError(t, err)
+	_, err = MakePeriodicPaymentSchedule(split.GetProgram(), models.NodeStatus{})
+	require.Error(t, err)
+}
+
 func TestDynamicFee(t *testing.T) {
 	// Inputs
 	receiver := "726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM"
@@ -135,6 +216,52 @@ func TestDynamicFee(t *testing.T) {
 	require.Equal(t, goldenStxns, base64.StdEncoding.EncodeToString(stxns))
 }
 
+func TestDynamicFeeOffer(t *testing.T) {
+	receiver := "726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM"
+	closeRemainder := "42NJMHTPFVPXVSDGA6JGKUV6TARV5UZTMPFIREMLXHETRKIVW34QFSDFRE"
+	artificialLease := "f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk="
+	c, err := makeDynamicFeeWithLease(receiver, closeRemainder, artificialLease, 5000, 12345, 12346)
+	require.NoError(t, err)
+	genesisBytes, err := base64.StdEncoding.DecodeString("f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=")
+	require.NoError(t, err)
+	payer := crypto.GenerateAccount()
+	feePayer := crypto.GenerateAccount()
+
+	// party A makes the offer and sends it to party B
+	offer, err := MakeDynamicFeeOffer(c.GetProgram(), payer.PrivateKey, genesisBytes)
+	require.NoError(t, err)
+	encoded := offer.Encode()
+
+	// party B checks it against the contract and pays the fee
+	received, err := DecodeDynamicFeeOffer(encoded)
+	require.NoError(t, err)
+	parameters, err := received.Verify()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), parameters.Amount)
+	require.Equal(t, receiver, parameters.Receiver.String())
+	stxns, err := received.Complete(feePayer.PrivateKey, 1234)
+	require.NoError(t, err)
+	expected, err := GetDynamicFeeTransactions(offer.Txn, offer.Lsig, feePayer.PrivateKey, 1234)
+	require.NoError(t, err)
+	require.Equal(t, expected, stxns)
+	group := decodeSignedGroup(t, stxns, 2)
+	require.Equal(t, payer.Address, group[0].Txn.Receiver)
+	require.Equal(t, group[1].Txn.Fee, group[0].Txn.Amount)
+
+	tampered := received
+	tampered.Txn.Amount++
+	_, err = tampered.Complete(feePayer.PrivateKey, 1234)
+	require.EqualError(t, err, "offer's amount 5001 does not match the contract's 5000")
+	tampered = received
+	tampered.Txn.LastValid++
+	_, err = tampered.Verify()
+	require.EqualError(t, err, "offer's validity window [12345, 12347] does not match the contract's [12345, 12346]")
+	tampered = received
+	tampered.Txn.Sender = feePayer.Address
+	_, err = tampered.Verify()
+	require.EqualError(t, err, "contract is not signed by the offer's sender "+feePayer.Address.String())
+}
+
 func TestLimitOrder(t *testing.T) {
 	// Inputs
 	owner := "726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM"
@@ -151,3 +278,737 @@ func TestLimitOrder(t *testing.T) {
 	goldenAddress := "LXQWT2XLIVNFS54VTLR63UY5K6AMIEWI7YTVE6LB4RWZDBZKH22ZO3S36I"
 	require.Equal(t, goldenAddress, c.GetAddress())
 }
+
+func TestSellLimitOrder(t *testing.T) {
+	// Inputs
+	owner := "726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM"
+	assetid := uint64(12345)
+	ratn, ratd := uint64(30), uint64(100)
+	expiryRound := uint64(123456)
+	minTrade := uint64(10000)
+	maxFee := uint64(5000000)
+	c, err := MakeSellLimitOrder(owner, assetid, ratn, ratd, expiryRound, minTrade, maxFee)
+	// Outputs
+	require.NoError(t, err)
+	goldenProgram := "AiALwJaxAgIBBLlgAwCQTmQewMQHJgEg/ryguxRKWk6ntDikaBrIDmyhBby2B/xWUyXJVpX2ohMxASIOMSAyAxIQMRMyAxIQQQEFMgQjEjMAECQSEEAAvzMAECUSMwARIQQSEEEA6TIEIxIzAAAzAQASEEAAbjIEIQUSQQDSMRYhBhIzABIhBw8QMwAVMgMSEDMBECQSEDMBBygSEDMCECQSEDMCBzEAEhAzAgAxABMQMwIIMwABDxBBAJQzAQghCB01AjUBMwASIQkdNQQ1AzQBNAMNQAB2NAE0AxI0AjQEDxBDMwAUKBIzABUoEhAzABIhBhIQMwEQJBIQMwEHMgMSEDMBCCEGEhAzAQkoEhAxAiEKDRBDMRYkEjMABzEAEhAzAAAxABMQMwAIMQEPEDEQJRIQMREhBBIQMRQxABIQMRIhBhIQMRUyAxIQQyRDIQZD"
+	require.Equal(t, goldenProgram, base64.StdEncoding.EncodeToString(c.GetProgram()))
+	goldenAddress := "CST3ESO6NCWLOQMCYQITXTNI4FOKRW4F2QVH4PSAK5KZXNNAGV3OUCWBXQ"
+	require.Equal(t, goldenAddress, c.GetAddress())
+
+	genesisBytes, err := base64.StdEncoding.DecodeString("f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=")
+	require.NoError(t, err)
+	params := types.SuggestedParams{
+		Fee:             1000,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisBytes,
+		FlatFee:         true,
+	}
+	funding, stxBytes, err := GetSellLimitOrderOptInTransaction(c.GetProgram(), owner, 200000, params)
+	require.NoError(t, err)
+	var optIn types.SignedTxn
+	require.NoError(t, msgpack.Decode(stxBytes, &optIn))
+	require.Equal(t, goldenAddress, optIn.Txn.AssetReceiver.String())
+	require.Equal(t, types.AssetIndex(assetid), optIn.Txn.XferAsset)
+	requireOptInRestricted(t, c.GetProgram(), funding, optIn.Txn)
+
+	buyer := crypto.GenerateAccount()
+	stxBytes, err = GetSellLimitOrderFillTransaction(c.GetProgram(), 20000, 6000, buyer.PrivateKey, params)
+	require.NoError(t, err)
+	group := decodeSignedGroup(t, stxBytes, 3)
+	require.Equal(t, c.GetProgram(), group[0].Lsig.Logic)
+	require.Equal(t, buyer.Address, group[0].Txn.AssetReceiver)
+	require.Equal(t, uint64(20000), group[0].Txn.AssetAmount)
+	require.Equal(t, buyer.Address, group[1].Txn.Sender)
+	require.Equal(t, owner, group[1].Txn.Receiver.String())
+	require.Equal(t, types.MicroAlgos(6000), group[1].Txn.Amount)
+	require.NotEqual(t, types.Signature{}, group[1].Sig)
+	require.Equal(t, buyer.Address, group[2].Txn.Sender)
+	require.Equal(t, goldenAddress, group[2].Txn.Receiver.String())
+	require.Equal(t, group[0].Txn.Fee, group[2].Txn.Amount)
+	require.NotEqual(t, types.Signature{}, group[2].Sig)
+	require.Equal(t, group[0].Txn.Group, group[1].Txn.Group)
+	require.Equal(t, group[0].Txn.Group, group[2].Txn.Group)
+	requireGroupApproved(t, c.GetProgram(), group)
+
+	// an account funded with only its minimum balance stays above it after a fill
+	balance := int64(funding.Amount) - int64(optIn.Txn.Fee)
+	require.Equal(t, int64(200000), balance)
+	balance += int64(group[2].Txn.Amount) - int64(group[0].Txn.Fee)
+	require.True(t, balance >= 200000)
+	// and fills which do not pay its fee back are rejected
+	fill := []types.Transaction{group[0].Txn, group[1].Txn, group[2].Txn}
+	requireFillRejected := func(group []types.Transaction) {
+		approved, err := evaluate(c.GetProgram(), group, 0)
+		require.False(t, err == nil && approved)
+	}
+	requireFillRejected(fill[:2])
+	underpaid := append([]types.Transaction(nil), fill...)
+	underpaid[2].Amount = fill[0].Fee - 1
+	requireFillRejected(underpaid)
+	selfPaid := append([]types.Transaction(nil), fill...)
+	selfPaid[2].Sender = fill[0].Sender
+	requireFillRejected(selfPaid)
+	misdirected := append([]types.Transaction(nil), fill...)
+	misdirected[2].Receiver = buyer.Address
+	requireFillRejected(misdirected)
+
+	_, err = GetSellLimitOrderFillTransaction(c.GetProgram(), 20000, 5999, buyer.PrivateKey, params)
+	require.EqualError(t, err, "5999 microAlgos for 20000 of the asset is below the contract's ratio of 30 per 100")
+	_, err = GetSellLimitOrderFillTransaction(c.GetProgram(), 9999, 6000, buyer.PrivateKey, params)
+	require.EqualError(t, err, "asset amount 9999 is below the contract's minimum trade 10000")
+
+	params.FirstRoundValid, params.LastRoundValid = 123457, 124457
+	stxBytes, err = GetSellLimitOrderRefundTransaction(c.GetProgram(), params)
+	require.NoError(t, err)
+	group = decodeSignedGroup(t, stxBytes, 2)
+	require.Equal(t, owner, group[0].Txn.AssetCloseTo.String())
+	require.Equal(t, owner, group[1].Txn.CloseRemainderTo.String())
+	buyOrder, err := MakeLimitOrder(owner, assetid, ratn, ratd, expiryRound, minTrade, maxFee)
+	require.NoError(t, err)
+	_, err = GetSellLimitOrderRefundTransaction(buyOrder.GetProgram(), params)
+	require.Error(t, err)
+}
+
+func TestEscrow(t *testing.T) {
+	// accounts from fixed seeds, so that the program and address are stable
+	account := func(seed byte) crypto.Account {
+		privateKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize))
+		publicKey := privateKey.Public().(ed25519.PublicKey)
+		var address types.Address
+		copy(address[:], publicKey)
+		return crypto.Account{PublicKey: publicKey, PrivateKey: privateKey, Address: address}
+	}
+	buyer, seller, arbiter := account(1), account(2), account(3)
+	expiryRound, maxFee := uint64(600000), uint64(1000)
+	c, err := MakeEscrow(buyer.Address.String(), seller.Address.String(), arbiter.Address.String(), expiryRound, maxFee)
+	require.NoError(t, err)
+	program := c.GetProgram()
+	goldenProgram := "AiAEAegHAMDPJCYDIIE5dw6ofRdfVqNUZsNMfszLjYqRtO43ol32D1uPybOUIIqI4910CfGV/VLbLTy6XXLKZwm/HZQSG/N0iAG0D29cIO1JKMYo0cLG6ukDOJBZlWEpWSc6XGP5NjbBRhSshzfRMRAiEjEBIw4QMSAyAxIQMQcyAxIQMQgkEhBBAC8xCSgSQAAaMQkpEkEAITECJQ1AABgxFy0oBDEXLioEEEMxFy0pBDEXLioEEEMiQyRD"
+	require.Equal(t, goldenProgram, base64.StdEncoding.EncodeToString(program))
+	goldenAddress := "G3RJGVM3XJUXTU4JSTFNKAJGTYCM2WIQRY2FCJV6K6CTYR4ANYGNJQX754"
+	require.Equal(t, goldenAddress, c.GetAddress())
+	parameters, err := Identify(program)
+	require.NoError(t, err)
+	require.Equal(t, EscrowParameters{Buyer: buyer.Address, Seller: seller.Address, Arbiter: arbiter.Address, ExpiryRound: expiryRound, MaxFee: maxFee}, parameters)
+
+	genesisBytes, err := base64.StdEncoding.DecodeString("f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=")
+	require.NoError(t, err)
+	params := types.SuggestedParams{
+		Fee:             1000,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisBytes,
+		FlatFee:         true,
+	}
+	sign := func(txn types.Transaction, account crypto.Account) types.Signature {
+		signature, err := SignEscrowTransaction(program, txn, account.PrivateKey)
+		require.NoError(t, err)
+		return signature
+	}
+
+	release, err := GetEscrowReleaseTransaction(program, params)
+	require.NoError(t, err)
+	require.Equal(t, seller.Address, release.CloseRemainderTo)
+	stxBytes, err := GetEscrowSignedTransaction(program, release, sign(release, buyer), sign(release, arbiter))
+	require.NoError(t, err)
+	var stx types.SignedTxn
+	require.NoError(t, msgpack.Decode(stxBytes, &stx))
+	require.Equal(t, program, stx.Lsig.Logic)
+	require.Len(t, stx.Lsig.Args, 2)
+	_, err = GetEscrowSignedTransaction(program, release, sign(release, seller), sign(release, arbiter))
+	require.EqualError(t, err, "transaction is not signed by the buyer")
+
+	refund, err := GetEscrowRefundTransaction(program, params)
+	require.NoError(t, err)
+	require.Equal(t, buyer.Address, refund.CloseRemainderTo)
+	_, err = GetEscrowSignedTransaction(program, refund, sign(refund, seller), sign(refund, arbiter))
+	require.NoError(t, err)
+	_, err = GetEscrowSignedTransaction(program, refund, sign(refund, seller), sign(release, arbiter))
+	require.EqualError(t, err, "transaction is not signed by the arbiter")
+
+	_, err = GetEscrowTimeoutTransaction(program, params)
+	require.EqualError(t, err, "first valid round 1 is not after the contract's expiry round 600000")
+	params.FirstRoundValid, params.LastRoundValid = 600001, 601000
+	stxBytes, err = GetEscrowTimeoutTransaction(program, params)
+	require.NoError(t, err)
+	stx = types.SignedTxn{}
+	require.NoError(t, msgpack.Decode(stxBytes, &stx))
+	require.Equal(t, buyer.Address, stx.Txn.CloseRemainderTo)
+
+	params.Fee = 1001
+	_, err = GetEscrowReleaseTransaction(program, params)
+	require.EqualError(t, err, "fee 1001 is above the contract's max fee 1000")
+}
+
+func TestAssetSplit(t *testing.T) {
+	// Inputs
+	owner := "WO3QIJ6T4DZHBX5PWJH26JLHFSRT7W7M2DJOULPXDTUS6TUX7ZRIO4KDFY"
+	receivers := [2]string{"W6UUUSEAOGLBHT7VFT4H2SDATKKSG6ZBUIJXTZMSLW36YS44FRP5NVAU7U", "XCIBIN7RT4ZXGBMVAMU3QS6L5EKB7XGROC5EPCNHHYXUIBAA5Q6C5Y7NEU"}
+	assetID := uint64(12345)
+	ratn, ratd := uint64(30), uint64(100)
+	expiryRound := uint64(123456)
+	minPay := uint64(10000)
+	maxFee := uint64(5000)
+	c, err := MakeAssetSplit(owner, receivers[0], receivers[1], assetID, ratn, ratd, expiryRound, minPay, maxFee)
+	// Outputs
+	require.NoError(t, err)
+	goldenProgram := "AiAKiCcCAQS5YGQekE4AwMQHJgMgt6lKSIBxlhPP9Sz4fUhgmpUjeyGiE3nlkl237EucLF8guJAUN/GfM3MFlQMpuEvL6RQf3NFwukeJpz4vRAQA7Dwgs7cEJ9Pg8nDfr7JPryVnLKM/2+zQ0uot9xzpL06X/mIxASIOMSAyAxIQMRMyAxIQQQDJMgQjEjMAECQSEEAAhTIEIxIzAAAzAQASEDMAECUSEDMAESEEEhBBAKAzARAkEkAANDMBECUSMwERIQQSEDMAFCgSEDMBFCkSEDEVMgMSEDMAEiEFCzMBEiEGCxIQMwASIQcPEEMzABQqEjMAFSoSEDMAEiEIEhAzAQcyAxIQMwEIIQgSEDMBCSoSEDECIQkNEEMxFiQSMwAHMQASEDMAADEAExAzAAgxAQ8QMRAlEhAxESEEEhAxFDEAEhAxEiEIEhAxFTIDEhBDIQhD"
+	require.Equal(t, goldenProgram, base64.StdEncoding.EncodeToString(c.GetProgram()))
+	goldenAddress := "IXVXINTC5CVSH3D7R477FKKBUJDA7W46GGZSRQ3GZ2RIEE7EAANP4CVKIA"
+	require.Equal(t, goldenAddress, c.GetAddress())
+
+	genesisBytes, err := base64.StdEncoding.DecodeString("f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=")
+	require.NoError(t, err)
+	params := types.SuggestedParams{
+		Fee:             1000,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisBytes,
+		FlatFee:         true,
+	}
+	funding, stxBytes, err := GetAssetSplitOptInTransaction(c.GetProgram(), owner, 200000, params)
+	require.NoError(t, err)
+	var optIn types.SignedTxn
+	require.NoError(t, msgpack.Decode(stxBytes, &optIn))
+	require.Equal(t, types.AssetTransferTx, optIn.Txn.Type)
+	require.Equal(t, goldenAddress, optIn.Txn.AssetReceiver.String())
+	require.Equal(t, types.AssetIndex(assetID), optIn.Txn.XferAsset)
+	require.Equal(t, uint64(0), optIn.Txn.AssetAmount)
+	require.Equal(t, goldenAddress, funding.Receiver.String())
+	require.Equal(t, types.MicroAlgos(201000), funding.Amount)
+	require.Equal(t, funding.Group, optIn.Txn.Group)
+	requireOptInRestricted(t, c.GetProgram(), funding, optIn.Txn)
+	_, _, err = GetAssetSplitOptInTransaction(c.GetProgram(), goldenAddress, 200000, params)
+	require.EqualError(t, err, "the contract account cannot fund its own opt-in")
+
+	stxBytes, err = GetAssetSplitTransaction(c.GetProgram(), 130000, params)
+	require.NoError(t, err)
+	group := decodeSignedGroup(t, stxBytes, 2)
+	requireGroupApproved(t, c.GetProgram(), group)
+	require.Equal(t, receivers[0], group[0].Txn.AssetReceiver.String())
+	require.Equal(t, uint64(30000), group[0].Txn.AssetAmount)
+	require.Equal(t, receivers[1], group[1].Txn.AssetReceiver.String())
+	require.Equal(t, uint64(100000), group[1].Txn.AssetAmount)
+	require.NotEqual(t, types.Digest{}, group[0].Txn.Group)
+	require.Equal(t, group[0].Txn.Group, group[1].Txn.Group)
+	_, err = GetAssetSplitTransaction(c.GetProgram(), 13000, params)
+	require.EqualError(t, err, "receiverOne would be paid 3000, less than the contract's minimum payment 10000")
+
+	_, err = GetAssetSplitRefundTransaction(c.GetProgram(), params)
+	require.EqualError(t, err, "first valid round 1 is not after the contract's expiry round 123456")
+	params.FirstRoundValid, params.LastRoundValid = 123457, 124457
+	stxBytes, err = GetAssetSplitRefundTransaction(c.GetProgram(), params)
+	require.NoError(t, err)
+	group = decodeSignedGroup(t, stxBytes, 2)
+	requireGroupApproved(t, c.GetProgram(), group)
+	require.Equal(t, owner, group[0].Txn.AssetCloseTo.String())
+	require.Equal(t, types.AssetIndex(assetID), group[0].Txn.XferAsset)
+	require.Equal(t, owner, group[1].Txn.CloseRemainderTo.String())
+}
+
+func TestAssetPeriodicPayment(t *testing.T) {
+	// Inputs
+	receiver := "SKXZDBHECM6AS73GVPGJHMIRDMJKEAN5TUGMUPSKJCQ44E6M6TC2H2UJ3I"
+	artificialLease := "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+	assetID := uint64(12345)
+	amount := uint64(500000)
+	withdrawalWindow := uint64(95)
+	period := uint64(100)
+	maxFee := uint64(1000)
+	expiryRound := uint64(2445756)
+	c, err := makeAssetPeriodicPaymentWithLease(receiver, artificialLease, assetID, amount, withdrawalWindow, period, expiryRound, maxFee)
+	// Outputs
+	require.NoError(t, err)
+	goldenProgram := "AiAK6AcCAQS5YGQAX6DCHryjlQEmAiCSr5GE5BM8CX9mq8yTsREbEqIBvZ0Myj5KSKHOE8z0xSABAgMEBQYHCAECAwQFBgcIAQIDBAUGBwgBAgMEBQYHCDEBIg4xIDIDEhAxEzIDEhBBAM0yBCMSMwAQJBIQQACJMgQjEkAAOjIEJBIxECUSEDERIQQSEDEVMgMSEDEUKBIQQQCcMQIhBRghBhIxBCEHMQIIEhAxBikSEDESIQgSEEMzAAAzAQASMwAQJRIQMwARIQQSEDMAFCgSEDMAFSgSEDMAEiEGEhAzARAkEhAzAQcyAxIQMwEIIQYSEDMBCSgSEDECIQkNEEMxFiQSMwAHMQASEDMAADEAExAzAAgxAQ8QMRAlEhAxESEEEhAxFDEAEhAxEiEGEhAxFTIDEhBDIQZD"
+	contractBytes := c.GetProgram()
+	require.Equal(t, goldenProgram, base64.StdEncoding.EncodeToString(contractBytes))
+	goldenAddress := "XXT64BNQ7FCHCA6R6ZVEJ3FKY7WKROBN6NYVZW6YEYH2SMOOHPMH3OUFHA"
+	require.Equal(t, goldenAddress, c.GetAddress())
+	genesisBytes, err := base64.StdEncoding.DecodeString("f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=")
+	require.NoError(t, err)
+
+	stxBytes, err := GetAssetPeriodicPaymentWithdrawalTransaction(contractBytes, 1200, 0, genesisBytes)
+	require.NoError(t, err)
+	var withdrawal types.SignedTxn
+	require.NoError(t, msgpack.Decode(stxBytes, &withdrawal))
+	requireGroupApproved(t, contractBytes, []types.SignedTxn{withdrawal})
+	require.Equal(t, receiver, withdrawal.Txn.AssetReceiver.String())
+	require.Equal(t, amount, withdrawal.Txn.AssetAmount)
+	require.Equal(t, types.AssetIndex(assetID), withdrawal.Txn.XferAsset)
+	require.Equal(t, types.Round(1295), withdrawal.Txn.LastValid)
+	lease, err := base64.StdEncoding.DecodeString(artificialLease)
+	require.NoError(t, err)
+	require.Equal(t, lease, withdrawal.Txn.Lease[:])
+	_, err = GetAssetPeriodicPaymentWithdrawalTransaction(contractBytes, 1201, 0, genesisBytes)
+	require.EqualError(t, err, "firstValid round 1201 was not a multiple of the contract period 100")
+
+	params := types.SuggestedParams{
+		Fee:             1000,
+		FirstRoundValid: 2445757,
+		LastRoundValid:  2446757,
+		GenesisHash:     genesisBytes,
+		FlatFee:         true,
+	}
+	funding, stxBytes, err := GetAssetPeriodicPaymentOptInTransaction(contractBytes, receiver, 200000, params)
+	require.NoError(t, err)
+	var optIn types.SignedTxn
+	require.NoError(t, msgpack.Decode(stxBytes, &optIn))
+	require.Equal(t, goldenAddress, optIn.Txn.AssetReceiver.String())
+	requireOptInRestricted(t, contractBytes, funding, optIn.Txn)
+	stxBytes, err = GetAssetPeriodicPaymentExpiryTransaction(contractBytes, params)
+	require.NoError(t, err)
+	group := decodeSignedGroup(t, stxBytes, 2)
+	requireGroupApproved(t, contractBytes, group)
+	require.Equal(t, receiver, group[0].Txn.AssetCloseTo.String())
+	require.Equal(t, receiver, group[1].Txn.CloseRemainderTo.String())
+	params.Fee = 1001
+	_, err = GetAssetPeriodicPaymentExpiryTransaction(contractBytes, params)
+	require.EqualError(t, err, "fee 1001 is above the contract's max fee 1000")
+}
+
+// requireGroupApproved evaluates contract for every transaction of a group signed by it
+func requireGroupApproved(t *testing.T, contract []byte, group []types.SignedTxn) {
+	txns := make([]types.Transaction, len(group))
+	for i, stx := range group {
+		txns[i] = stx.Txn
+	}
+	evaluated := 0
+	for i, stx := range group {
+		if !bytes.Equal(contract, stx.Lsig.Logic) {
+			continue
+		}
+		approved, err := evaluate(contract, txns, i)
+		require.NoError(t, err)
+		require.True(t, approved, "transaction %d", i)
+		evaluated++
+	}
+	require.NotZero(t, evaluated)
+}
+
+// requireOptInRestricted checks contract approves a funded opt-in, and no opt-in that would
+// spend the contract account's Algos on its fee
+func requireOptInRestricted(t *testing.T, contract []byte, funding, optIn types.Transaction) {
+	requireApproved := func(group []types.Transaction, approve bool) {
+		// a program failing with an error rejects the transaction too
+		approved, err := evaluate(contract, group, len(group)-1)
+		require.Equal(t, approve, err == nil && approved)
+	}
+	requireApproved([]types.Transaction{funding, optIn}, true)
+	// alone, as anybody could resubmit it every round
+	requireApproved([]types.Transaction{optIn}, false)
+	// or with a payment not covering its fee
+	underpaid := funding
+	underpaid.Amount = optIn.Fee - 1
+	requireApproved([]types.Transaction{underpaid, optIn}, false)
+	// or from the contract account itself
+	selfFunded := funding
+	selfFunded.Sender = optIn.Sender
+	requireApproved([]types.Transaction{selfFunded, optIn}, false)
+	// or to another account
+	misdirected := funding
+	misdirected.Receiver = funding.Sender
+	requireApproved([]types.Transaction{misdirected, optIn}, false)
+}
+
+// decodeSignedGroup decodes size concatenated signed transactions
+func decodeSignedGroup(t *testing.T, stxBytes []byte, size int) []types.SignedTxn {
+	decoder := msgpack.NewDecoder(bytes.NewReader(stxBytes))
+	group := make([]types.SignedTxn, size)
+	for i := range group {
+		require.NoError(t, decoder.Decode(&group[i]))
+	}
+	return group
+}
+
+func TestBuilderVerification(t *testing.T) {
+	owner := "726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM"
+	receiver := "42NJMHTPFVPXVSDGA6JGKUV6TARV5UZTMPFIREMLXHETRKIVW34QFSDFRE"
+	genesisBytes, err := base64.StdEncoding.DecodeString("f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=")
+	require.NoError(t, err)
+	params := types.SuggestedParams{
+		Fee:             1000,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisBytes,
+		FlatFee:         true,
+	}
+	buyer := crypto.GenerateAccount()
+
+	limitOrder, err := MakeLimitOrder(owner, 12345, 30, 100, 123456, 10000, 5000)
+	require.NoError(t, err)
+	stxBytes, err := limitOrder.GetSwapAssetsTransaction(30000, 100000, limitOrder.GetProgram(), buyer.PrivateKey, params)
+	require.NoError(t, err)
+	group := decodeSignedGroup(t, stxBytes, 2)
+	require.Equal(t, buyer.Address, group[0].Txn.Receiver)
+	require.Equal(t, buyer.Address, group[1].Txn.Sender)
+
+	// a program made with other parameters than the order's is refused
+	lookalike, err := MakeLimitOrder(owner, 12345, 30, 100, 123456, 1, 5000)
+	require.NoError(t, err)
+	_, err = limitOrder.GetSwapAssetsTransaction(30000, 100000, lookalike.GetProgram(), buyer.PrivateKey, params)
+	var parameterMismatch ParameterMismatchError
+	require.True(t, errors.As(err, &parameterMismatch))
+	require.Equal(t, ParameterMismatchError{Template: "LimitOrder", Parameter: "MinTrade", Expected: uint64(10000), Actual: uint64(1)}, parameterMismatch)
+	require.EqualError(t, err, "LimitOrder contract has MinTrade 1, expected 10000")
+
+	// as is a program which is not a limit order at all
+	split, err := MakeSplit(owner, receiver, owner, 30, 100, 123456, 10000, 5000)
+	require.NoError(t, err)
+	_, err = limitOrder.GetSwapAssetsTransaction(30000, 100000, split.GetProgram(), buyer.PrivateKey, params)
+	var templateMismatch TemplateMismatchError
+	require.True(t, errors.As(err, &templateMismatch))
+	require.Equal(t, "LimitOrder", templateMismatch.Template)
+	_, err = GetSplitFundsTransaction(limitOrder.GetProgram(), 130000, params)
+	require.True(t, errors.As(err, &templateMismatch))
+	require.Equal(t, "Split", templateMismatch.Template)
+
+	var constraint ConstraintError
+	_, err = limitOrder.GetSwapAssetsTransaction(29999, 100000, limitOrder.GetProgram(), buyer.PrivateKey, params)
+	require.True(t, errors.As(err, &constraint))
+	require.EqualError(t, err, "29999 of the asset for 100000 microAlgos is below the contract's ratio of 30 per 100")
+	_, err = limitOrder.GetSwapAssetsTransaction(3000, 10000, limitOrder.GetProgram(), buyer.PrivateKey, params)
+	require.EqualError(t, err, "10000 microAlgos is not above the contract's minimum trade 10000")
+	_, err = limitOrder.GetSwapAssetsTransaction(30000, 100000, limitOrder.GetProgram(), buyer.PrivateKey[:32], params)
+	require.EqualError(t, err, "secret key is 32 bytes long, expected 64")
+	_, err = GetSplitFundsTransaction(split.GetProgram(), 13000, params)
+	require.True(t, errors.As(err, &constraint))
+	require.EqualError(t, err, "receiverOne would be paid 3000, less than the contract's minimum payment 10000")
+	params.Fee = 5000
+	_, err = GetSplitFundsTransaction(split.GetProgram(), 130000, params)
+	require.EqualError(t, err, "fee 5000 is not below the contract's max fee 5000")
+
+	periodicPayment, err := MakePeriodicPayment(receiver, 500000, 95, 100, 2445756, 1000)
+	require.NoError(t, err)
+	_, err = GetPeriodicPaymentWithdrawalTransaction(periodicPayment.GetProgram(), 1200, 10, genesisBytes)
+	require.True(t, errors.As(err, &constraint))
+
+	htlc, err := MakeHTLC(owner, receiver, "sha256", "EHZhE08h/HwCIj1Qq56zYAvD/8NxJCOh5Hux+anb9V8=", 600000, 1000)
+	require.NoError(t, err)
+	_, _, err = SignTransactionWithHTLCUnlock(htlc.GetProgram(), types.Transaction{}, base64.StdEncoding.EncodeToString([]byte("wrong")))
+	require.EqualError(t, err, "sha256 of the preimage does not match the contract's hash image")
+	_, _, err = SignTransactionWithHTLCUnlock(split.GetProgram(), types.Transaction{}, "")
+	require.True(t, errors.As(err, &templateMismatch))
+}
+
+func TestTemplate(t *testing.T) {
+	template, err := MakeTemplateFromSource(`#pragma version 2
+txn Fee
+int TMPL_FEE
+<=
+txn Receiver
+addr TMPL_RCV
+==
+&&
+arg 0
+byte base64 TMPL_SECRET
+==
+&&
+txn Amount
+int TMPL_FEE
+>=
+&&`)
+	require.NoError(t, err)
+	require.Equal(t, []Placeholder{
+		{Name: "TMPL_FEE", Type: UintParameter, Offset: 3},
+		{Name: "TMPL_RCV", Type: AddressParameter, Offset: 6},
+		{Name: "TMPL_SECRET", Type: BytesParameter, Offset: 39},
+	}, template.GetPlaceholders())
+
+	receiver, err := types.DecodeAddress("W6UUUSEAOGLBHT7VFT4H2SDATKKSG6ZBUIJXTZMSLW36YS44FRP5NVAU7U")
+	require.NoError(t, err)
+	values := map[string]interface{}{
+		"TMPL_FEE":    uint64(300000),
+		"TMPL_RCV":    receiver,
+		"TMPL_SECRET": []byte("a longer secret than the placeholder"),
+	}
+	program, err := template.Inject(values)
+	require.NoError(t, err)
+	ints, byteArrays, err := logic.ReadProgram(program, nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{300000}, ints)
+	require.Equal(t, [][]byte{receiver[:], []byte("a longer secret than the placeholder")}, byteArrays)
+
+	extracted, err := template.Extract(program)
+	require.NoError(t, err)
+	require.Equal(t, values, extracted)
+
+	// a program from the same template can be described by its bytecode alone
+	fromBytecode, err := MakeTemplate(program, []Placeholder{
+		{Name: "secret", Type: BytesParameter, Offset: 41},
+		{Name: "fee", Type: UintParameter, Offset: 3},
+	})
+	require.NoError(t, err)
+	extracted, err = fromBytecode.Extract(program)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"fee": uint64(300000), "secret": []byte("a longer secret than the placeholder")}, extracted)
+
+	_, err = template.Inject(map[string]interface{}{"TMPL_FEE": uint64(1), "TMPL_RCV": receiver})
+	require.EqualError(t, err, "no value for placeholder TMPL_SECRET")
+	values["TMPL_OTHER"] = uint64(1)
+	_, err = template.Inject(values)
+	require.EqualError(t, err, "template has no placeholder TMPL_OTHER")
+	delete(values, "TMPL_OTHER")
+	values["TMPL_FEE"] = 5
+	_, err = template.Inject(values)
+	require.EqualError(t, err, "placeholder TMPL_FEE: value of type int cannot be used as a uint")
+
+	program[len(program)-1] = 0x11 // || instead of &&
+	_, err = template.Extract(program)
+	require.EqualError(t, err, "program does not match template after the last placeholder")
+
+	_, err = MakeTemplate(program, []Placeholder{{Name: "fee", Type: AddressParameter, Offset: 3}})
+	require.EqualError(t, err, "placeholder fee at offset 3 is not a constant of type address")
+	_, err = MakeTemplate(program, []Placeholder{{Name: "fee", Type: UintParameter, Offset: 4}})
+	require.EqualError(t, err, "placeholder fee at offset 4 is not a constant of type uint")
+	_, err = MakeTemplateFromSource("int TMPL_X\naddr TMPL_X")
+	require.EqualError(t, err, "2: TMPL_X is used with both int and addr")
+}
+
+func TestBuiltinTemplates(t *testing.T) {
+	htlc, err := MakeHTLC("726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM", "42NJMHTPFVPXVSDGA6JGKUV6TARV5UZTMPFIREMLXHETRKIVW34QFSDFRE", "sha256", "EHZhE08h/HwCIj1Qq56zYAvD/8NxJCOh5Hux+anb9V8=", 600000, 1000)
+	require.NoError(t, err)
+	template, err := htlcTemplate("sha256")
+	require.NoError(t, err)
+	values, err := template.Extract(htlc.GetProgram())
+	require.NoError(t, err)
+	require.Equal(t, uint64(600000), values["TMPL_TIMEOUT"])
+	require.Equal(t, uint64(1000), values["TMPL_FEE"])
+
+	for _, makeTemplate := range []func() (Template, error){splitTemplate, limitOrderTemplate, periodicPaymentTemplate, dynamicFeeTemplate, assetSplitTemplate, assetPeriodicPaymentTemplate, sellLimitOrderTemplate, escrowTemplate} {
+		_, err = makeTemplate()
+		require.NoError(t, err)
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	owner := "726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM"
+	receiver := "42NJMHTPFVPXVSDGA6JGKUV6TARV5UZTMPFIREMLXHETRKIVW34QFSDFRE"
+	ownerAddr, err := types.DecodeAddress(owner)
+	require.NoError(t, err)
+	receiverAddr, err := types.DecodeAddress(receiver)
+	require.NoError(t, err)
+	lease := [32]byte{1, 2, 3}
+	leaseString := base64.StdEncoding.EncodeToString(lease[:])
+
+	split, err := MakeSplit(owner, receiver, owner, 30, 100, 123456, 10000, 5000000)
+	require.NoError(t, err)
+	htlc, err := MakeHTLC(owner, receiver, "keccak256", "EHZhE08h/HwCIj1Qq56zYAvD/8NxJCOh5Hux+anb9V8=", 600000, 1000)
+	require.NoError(t, err)
+	limitOrder, err := MakeLimitOrder(owner, 12345, 30, 100, 123456, 10000, 5000000)
+	require.NoError(t, err)
+	periodicPayment, err := makePeriodicPaymentWithLease(receiver, leaseString, 500000, 95, 100, 2445756, 1000)
+	require.NoError(t, err)
+	dynamicFee, err := makeDynamicFeeWithLease(receiver, owner, leaseString, 5000, 12345, 12346)
+	require.NoError(t, err)
+	assetSplit, err := MakeAssetSplit(owner, receiver, owner, 12345, 30, 100, 123456, 10000, 5000)
+	require.NoError(t, err)
+	assetPeriodicPayment, err := makeAssetPeriodicPaymentWithLease(receiver, leaseString, 12345, 500000, 95, 100, 2445756, 1000)
+	require.NoError(t, err)
+	sellLimitOrder, err := MakeSellLimitOrder(owner, 12345, 30, 100, 123456, 10000, 5000000)
+	require.NoError(t, err)
+
+	hashImage, err := base64.StdEncoding.DecodeString("EHZhE08h/HwCIj1Qq56zYAvD/8NxJCOh5Hux+anb9V8=")
+	require.NoError(t, err)
+	for _, test := range []struct {
+		program  []byte
+		expected TemplateParameters
+	}{
+		{split.GetProgram(), SplitParameters{Owner: ownerAddr, ReceiverOne: receiverAddr, ReceiverTwo: ownerAddr, Ratn: 30, Ratd: 100, ExpiryRound: 123456, MinPay: 10000, MaxFee: 5000000}},
+		{htlc.GetProgram(), HTLCParameters{Owner: ownerAddr, Receiver: receiverAddr, HashFunction: "keccak256", HashImage: hashImage, ExpiryRound: 600000, MaxFee: 1000}},
+		{limitOrder.GetProgram(), LimitOrderParameters{Owner: ownerAddr, AssetID: 12345, Ratn: 30, Ratd: 100, ExpiryRound: 123456, MinTrade: 10000, MaxFee: 5000000}},
+		{periodicPayment.GetProgram(), PeriodicPaymentParameters{Receiver: receiverAddr, Amount: 500000, WithdrawWindow: 95, Period: 100, ExpiryRound: 2445756, MaxFee: 1000, Lease: lease}},
+		{dynamicFee.GetProgram(), DynamicFeeParameters{Receiver: receiverAddr, CloseRemainder: ownerAddr, Amount: 5000, FirstValid: 12345, LastValid: 12346, Lease: lease}},
+		{assetSplit.GetProgram(), AssetSplitParameters{Owner: ownerAddr, ReceiverOne: receiverAddr, ReceiverTwo: ownerAddr, AssetID: 12345, Ratn: 30, Ratd: 100, ExpiryRound: 123456, MinPay: 10000, MaxFee: 5000}},
+		{assetPeriodicPayment.GetProgram(), AssetPeriodicPaymentParameters{Receiver: receiverAddr, AssetID: 12345, Amount: 500000, WithdrawWindow: 95, Period: 100, ExpiryRound: 2445756, MaxFee: 1000, Lease: lease}},
+		{sellLimitOrder.GetProgram(), SellLimitOrderParameters{Owner: ownerAddr, AssetID: 12345, Ratn: 30, Ratd: 100, ExpiryRound: 123456, MinTrade: 10000, MaxFee: 5000000}},
+	} {
+		parameters, err := Identify(test.program)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, parameters, test.expected.TemplateName())
+	}
+
+	_, err = Identify([]byte{0x01, 0x20, 0x01, 0x01, 0x22})
+	require.EqualError(t, err, "program does not match any known template")
+}
+
+func TestRefundTransactions(t *testing.T) {
+	owner := "726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM"
+	receiver := "42NJMHTPFVPXVSDGA6JGKUV6TARV5UZTMPFIREMLXHETRKIVW34QFSDFRE"
+	genesisBytes, err := base64.StdEncoding.DecodeString("f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=")
+	require.NoError(t, err)
+	params := types.SuggestedParams{
+		Fee:             1000,
+		FirstRoundValid: 700000,
+		LastRoundValid:  701000,
+		GenesisHash:     genesisBytes,
+		FlatFee:         true,
+	}
+	decode := func(stxBytes []byte) types.SignedTxn {
+		var stx types.SignedTxn
+		require.NoError(t, msgpack.Decode(stxBytes, &stx))
+		return stx
+	}
+	requireCloseOut := func(stx types.SignedTxn, contract []byte, closeTo string) {
+		require.Equal(t, contract, stx.Lsig.Logic)
+		require.Equal(t, crypto.AddressFromProgram(contract), stx.Txn.Sender)
+		require.Equal(t, types.Address{}, stx.Txn.Receiver)
+		require.Equal(t, types.MicroAlgos(0), stx.Txn.Amount)
+		require.Equal(t, closeTo, stx.Txn.CloseRemainderTo.String())
+	}
+
+	split, err := MakeSplit(owner, receiver, receiver, 30, 100, 123456, 10000, 5000)
+	require.NoError(t, err)
+	stxBytes, err := GetSplitRefundTransaction(split.GetProgram(), params)
+	require.NoError(t, err)
+	requireCloseOut(decode(stxBytes), split.GetProgram(), owner)
+	// the split contract's fee limit is exclusive
+	splitAtMaxFee, err := MakeSplit(owner, receiver, receiver, 30, 100, 123456, 10000, 1000)
+	require.NoError(t, err)
+	_, err = GetSplitRefundTransaction(splitAtMaxFee.GetProgram(), params)
+	require.EqualError(t, err, "fee 1000 is not below the contract's max fee 1000")
+
+	htlc, err := MakeHTLC(owner, receiver, "sha256", "EHZhE08h/HwCIj1Qq56zYAvD/8NxJCOh5Hux+anb9V8=", 600000, 1000)
+	require.NoError(t, err)
+	stxBytes, err = GetHTLCRefundTransaction(htlc.GetProgram(), params)
+	require.NoError(t, err)
+	stx := decode(stxBytes)
+	requireCloseOut(stx, htlc.GetProgram(), owner)
+	require.Len(t, stx.Lsig.Args, 1)
+	_, err = GetHTLCRefundTransaction(split.GetProgram(), params)
+	require.Error(t, err)
+
+	limitOrder, err := MakeLimitOrder(owner, 12345, 30, 100, 800000, 10000, 5000)
+	require.NoError(t, err)
+	_, err = GetLimitOrderRefundTransaction(limitOrder.GetProgram(), params)
+	require.EqualError(t, err, "first valid round 700000 is not after the contract's expiry round 800000")
+	limitOrder, err = MakeLimitOrder(owner, 12345, 30, 100, 123456, 10000, 999)
+	require.NoError(t, err)
+	_, err = GetLimitOrderRefundTransaction(limitOrder.GetProgram(), params)
+	require.EqualError(t, err, "fee 1000 is above the contract's max fee 999")
+
+	lease := [32]byte{1, 2, 3}
+	periodicPayment, err := makePeriodicPaymentWithLease(receiver, base64.StdEncoding.EncodeToString(lease[:]), 500000, 95, 100, 600000, 1000)
+	require.NoError(t, err)
+	stxBytes, err = GetPeriodicPaymentExpiryTransaction(periodicPayment.GetProgram(), params)
+	require.NoError(t, err)
+	stx = decode(stxBytes)
+	requireCloseOut(stx, periodicPayment.GetProgram(), receiver)
+	require.Equal(t, lease, stx.Txn.Lease)
+	require.Equal(t, types.Round(700095), stx.Txn.LastValid)
+	params.FirstRoundValid++
+	_, err = GetPeriodicPaymentExpiryTransaction(periodicPayment.GetProgram(), params)
+	require.EqualError(t, err, "firstValid round 700001 was not a multiple of the contract period 100")
+	zeroPeriod, err := makePeriodicPaymentWithLease(receiver, base64.StdEncoding.EncodeToString(lease[:]), 500000, 95, 0, 600000, 1000)
+	require.NoError(t, err)
+	_, err = GetPeriodicPaymentExpiryTransaction(zeroPeriod.GetProgram(), params)
+	require.EqualError(t, err, "contract period is zero")
+}
+
+func TestContractAccount(t *testing.T) {
+	owner := "726KBOYUJJNE5J5UHCSGQGWIBZWKCBN4WYD7YVSTEXEVNFPWUIJ7TAEOPM"
+	funder := "W6UUUSEAOGLBHT7VFT4H2SDATKKSG6ZBUIJXTZMSLW36YS44FRP5NVAU7U"
+	assetid := uint64(12345)
+	expiryRound := uint64(123456)
+	c, err := MakeSellLimitOrder(owner, assetid, 30, 100, expiryRound, 10000, 2000)
+	require.NoError(t, err)
+
+	account := models.Account{Address: c.GetAddress(), Amount: 0, Round: 100}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/accounts/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/accounts/"+c.GetAddress(), r.URL.Path)
+		assert.NoError(t, json.NewEncoder(w).Encode(account))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client, err := algod.MakeClient(server.URL, "")
+	require.NoError(t, err)
+
+	manager, err := MakeContractAccount(c.ContractTemplate, client)
+	require.NoError(t, err)
+	require.Equal(t, c.GetAddress(), manager.Address.String())
+	require.Equal(t, []uint64{assetid}, manager.AssetIDs())
+	require.Equal(t, expiryRound, manager.ExpiryRound())
+	require.Equal(t, uint64(200000), manager.MinimumBalance())
+
+	genesisBytes, err := base64.StdEncoding.DecodeString("f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk=")
+	require.NoError(t, err)
+	params := types.SuggestedParams{
+		Fee:             1000,
+		FirstRoundValid: 1,
+		LastRoundValid:  100,
+		GenesisHash:     genesisBytes,
+		FlatFee:         true,
+	}
+	funding, signedOptIns, err := manager.GetFundingTransactions(funder, 5000, params)
+	require.NoError(t, err)
+	require.Equal(t, funder, funding.Sender.String())
+	require.Equal(t, c.GetAddress(), funding.Receiver.String())
+	require.Equal(t, types.MicroAlgos(200000+1000+5000), funding.Amount)
+	var optIn types.SignedTxn
+	require.NoError(t, msgpack.Decode(signedOptIns, &optIn))
+	require.Equal(t, types.AssetIndex(assetid), optIn.Txn.XferAsset)
+	require.Equal(t, funding.Group, optIn.Txn.Group)
+	require.NotEqual(t, types.Digest{}, funding.Group)
+
+	// the funding group is approved by each of the asset templates
+	assetSplit, err := MakeAssetSplit(owner, funder, owner, assetid, 30, 100, expiryRound, 10000, 2000)
+	require.NoError(t, err)
+	assetPeriodicPayment, err := MakeAssetPeriodicPayment(owner, assetid, 10000, 95, 100, expiryRound, 2000)
+	require.NoError(t, err)
+	for _, contract := range []ContractTemplate{c.ContractTemplate, assetSplit.ContractTemplate, assetPeriodicPayment.ContractTemplate} {
+		assetManager, err := MakeContractAccount(contract, client)
+		require.NoError(t, err)
+		funding, signedOptIns, err := assetManager.GetFundingTransactions(funder, 5000, params)
+		require.NoError(t, err)
+		var optIn types.SignedTxn
+		require.NoError(t, msgpack.Decode(signedOptIns, &optIn))
+		approved, err := evaluate(contract.GetProgram(), []types.Transaction{funding, optIn.Txn}, 1)
+		require.NoError(t, err)
+		require.True(t, approved, assetManager.Parameters.TemplateName())
+	}
+	split, err := MakeSplit(owner, funder, owner, 30, 100, expiryRound, 10000, 2000)
+	require.NoError(t, err)
+	splitManager, err := MakeContractAccount(split.ContractTemplate, client)
+	require.NoError(t, err)
+	funding, signedOptIns, err = splitManager.GetFundingTransactions(funder, 5000, params)
+	require.NoError(t, err)
+	require.Nil(t, signedOptIns)
+	require.Equal(t, types.MicroAlgos(100000+5000), funding.Amount)
+	require.Equal(t, types.Digest{}, funding.Group)
+
+	state, _, err := manager.State(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ContractUnfunded, state)
+	account.Amount = 205000
+	state, _, err = manager.State(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ContractUnfunded, state)
+	account.Assets = []models.AssetHolding{{AssetId: assetid}}
+	state, read, err := manager.State(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ContractActive, state)
+	require.Equal(t, uint64(205000), read.Amount)
+	account.Round = expiryRound
+	require.Equal(t, ContractExpired, manager.StateAt(account))
+	account.Amount = 0
+	require.Equal(t, "closed", manager.StateAt(account).String())
+
+	params.FirstRoundValid, params.LastRoundValid = types.Round(expiryRound+1), types.Round(expiryRound+100)
+	stxBytes, err := manager.GetSweepTransactions(params)
+	require.NoError(t, err)
+	group := decodeSignedGroup(t, stxBytes, 2)
+	require.Equal(t, owner, group[0].Txn.AssetCloseTo.String())
+	require.Equal(t, owner, group[1].Txn.CloseRemainderTo.String())
+
+	params.Fee = 3000
+	_, _, err = manager.GetFundingTransactions(funder, 0, params)
+	var constraint ConstraintError
+	require.True(t, errors.As(err, &constraint))
+
+	lease := "f4OxZX/x/FO5LcGBSKHWXfwtSx+j1ncoSt3SABJtkGk="
+	dynamicFee, err := makeDynamicFeeWithLease(owner, "", lease, 5000000, 12345, 12346)
+	require.NoError(t, err)
+	_, err = MakeContractAccount(dynamicFee.ContractTemplate, client)
+	require.Error(t, err)
+}